@@ -1,9 +1,13 @@
 package adapters
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"github.com/goccy/go-json"
 	"reflect"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -19,6 +23,31 @@ type ConverterFunc func(src interface{}) (interface{}, error)
 // ValidatorFunc validates a field value after conversion and assignment candidate.
 type ValidatorFunc func(value interface{}) error
 
+// FallbackConverterFunc is a last-resort converter for fields whose types are neither assignable nor convertible.
+// It receives the destination type so it can produce an assignable value; returning Skip leaves the field untouched.
+type FallbackConverterFunc func(src interface{}, dstType reflect.Type) (interface{}, error)
+
+// FieldSkippedFunc observes a matched field that was skipped because its types are incompatible.
+type FieldSkippedFunc func(field string, srcType, dstType reflect.Type)
+
+// Skip may be returned by a FallbackConverterFunc to leave the destination field unchanged.
+var Skip = errors.New("adapters: skip field")
+
+// ErrAdditionalDataNotObject is wrapped by the error Into returns when source AdditionalData is valid JSON
+// but not an object (an array, string, number or boolean). Malformed JSON is reported as "invalid JSON".
+var ErrAdditionalDataNotObject = errors.New("adapters: AdditionalData is not a JSON object")
+
+// PreprocessFunc rewrites raw source AdditionalData before it is decoded, e.g. to strip a BOM or unwrap
+// double-encoded JSON. It must not modify its input in place: the bytes belong to the source struct.
+type PreprocessFunc func(raw []byte) ([]byte, error)
+
+// MarshalTransformFunc transforms a source field value before it is stored in destination AdditionalData.
+type MarshalTransformFunc func(value interface{}) interface{}
+
+// StructConverterFunc runs after field-level adaptation with pointers to the destination and source structs.
+// It is the escape hatch for cross-field derivations that do not fit the per-field converter model.
+type StructConverterFunc func(dst, src interface{}) error
+
 // Composition helpers
 // ComposeConverters chains multiple ConverterFunc instances left-to-right.
 // If any converter returns an error it aborts.
@@ -40,6 +69,38 @@ func ComposeConverters(fns ...ConverterFunc) ConverterFunc {
 	}
 }
 
+// AllValidators returns a ValidatorFunc that runs fns in order and fails with the first error, skipping the
+// rest. With no fns it always passes.
+func AllValidators(fns ...ValidatorFunc) ValidatorFunc {
+	return func(value interface{}) error {
+		for _, fn := range fns {
+			if err := fn(value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// AnyValidator returns a ValidatorFunc that passes as soon as one of fns passes. If all fail it returns
+// their errors joined with errors.Join, in order, so errors.Is/As see each of them. With no fns it always fails.
+func AnyValidator(fns ...ValidatorFunc) ValidatorFunc {
+	return func(value interface{}) error {
+		if len(fns) == 0 {
+			return errors.New("no validator to satisfy")
+		}
+		errs := make([]error, 0, len(fns))
+		for _, fn := range fns {
+			err := fn(value)
+			if err == nil {
+				return nil
+			}
+			errs = append(errs, err)
+		}
+		return errors.Join(errs...)
+	}
+}
+
 // MapString returns a ConverterFunc applying f when src is a string; otherwise returns src unchanged.
 func MapString(f func(string) string) ConverterFunc {
 	return func(src interface{}) (interface{}, error) {
@@ -58,12 +119,54 @@ const (
 	PreferAdditionalData                        // overwrite fields with values from AdditionalData if present
 )
 
+// EmptyAdditionalData controls what is written to destination AdditionalData when no fields remain to marshal
+type EmptyAdditionalData int
+
+const (
+	EmptyAsNull     EmptyAdditionalData = iota // default: invalid null.JSON{} / nil types.JSON (SQL NULL for most drivers)
+	EmptyAsObject                              // valid `{}` for both JSON types
+	EmptyAsJSONNull                            // valid JSON literal `null` for both JSON types
+)
+
 type Options struct {
-	IncludeZeroValues              bool            // when true, include zero-valued fields in marshaled AdditionalData
-	CaseInsensitiveAdditionalData  bool            // when true, AdditionalData keys are matched case-insensitively
-	OverwritePolicy                OverwritePolicy // controls if AdditionalData overwrites direct fields
-	DisableMarshalAdditionalData   bool            // when true, do not marshal remaining fields into destination AdditionalData
-	DisableUnmarshalAdditionalData bool            // when true, ignore source AdditionalData
+	IncludeZeroValues              bool                  // when true, include zero-valued fields in marshaled AdditionalData
+	CaseInsensitiveAdditionalData  bool                  // when true, AdditionalData keys are matched case-insensitively
+	OverwritePolicy                OverwritePolicy       // controls if AdditionalData overwrites direct fields
+	DisableMarshalAdditionalData   bool                  // when true, do not marshal remaining fields into destination AdditionalData
+	DisableUnmarshalAdditionalData bool                  // when true, ignore source AdditionalData
+	CacheObserver                  func(CacheEvent)      // optional hook fired on metadata/plan cache hits and misses
+	NullAware                      bool                  // when true, copy between null wrappers (null.X, sql.NullX) and their plain types
+	EmptyStringAsNull              bool                  // with NullAware, an empty source string produces an invalid (null) wrapper
+	ImplicitSnakeCaseJSON          bool                  // when true, fields without a json tag get an implied snake_case json name
+	RollbackOnCancel               bool                  // when true, AdaptSliceCtx/AdaptMapCtx leave dst untouched on cancellation or error
+	FallbackConverter              FallbackConverterFunc // last resort for matched fields with incompatible types
+	EmptyAdditionalData            EmptyAdditionalData   // what to write to destination AdditionalData when nothing remains
+	AllowImplicitConvert           bool                  // default true: copy between convertible (not only assignable) types
+	ErrorOnUnmappedSource          bool                  // when true, a source field that would be dropped fails Into
+	OnFieldSkipped                 FieldSkippedFunc      // observes matched fields skipped as incompatible
+	FieldMatcher                   FieldMatcher          // resolves the source field for each destination field; nil uses DefaultMatcher
+	PanicOnBuildError              bool                  // development only: panic on malformed struct metadata instead of returning the error
+	NestedStructs                  bool                  // when true, adapt named struct / *struct fields of differing types recursively
+	LowercaseAdditionalDataKeys    bool                  // when true, keys marshaled into AdditionalData are lowercased
+	IncludeFields                  []string              // when non-empty, only these destination fields (Go names) are written
+	ExcludeFields                  []string              // destination fields (Go names) never written; wins over IncludeFields
+	CoerceStringNumbers            bool                  // when true, quoted numbers in source AdditionalData fill numeric fields
+	AdditionalDataPreprocessor     PreprocessFunc        // rewrites source AdditionalData bytes before they are decoded
+	MergeAdditionalData            bool                  // when true, merge into existing destination AdditionalData instead of replacing it
+	MergeKeepExisting              bool                  // with MergeAdditionalData, existing keys win over new fields on collision
+	DisableMetadataCache           bool                  // when true, struct metadata and plans are rebuilt on every use instead of cached
+	RespectJSONDash                bool                  // when true, json:"-" fields are kept out of AdditionalData in both directions
+	SkipNonObjectAdditionalData    bool                  // when true, source AdditionalData that is a JSON array or scalar is ignored
+	StructToJSONField              bool                  // when true, struct/map/slice fields are copied to and from string/[]byte fields as JSON
+	ParallelThreshold              int                   // when > 0, plans with more fields than this copy them concurrently
+	AdditionalDataKeyEncode        func(string) string   // maps a Go field name to the key marshaled into AdditionalData
+	AdditionalDataKeyDecode        func(string) string   // maps a source AdditionalData key to the field name it fills
+	DynamicInterfaceSources        bool                  // when true, interface-typed source fields are adapted from their dynamic value
+	AdditionalDataFieldName        string                // Go field name treated as AdditionalData without a tag, besides "AdditionalData"
+	TimeParseLayout                string                // layout for string -> time.Time/null.Time fields without a converter; "" disables
+	TimeFormatLayout               string                // layout for time.Time/null.Time -> string fields without a converter; "" disables
+	ClearDestinationAdditionalData bool                  // when true, destination AdditionalData is reset before every adaptation
+	AutoPointers                   bool                  // when true, *T and T fields are copied into each other
 }
 
 type Option func(*Options)
@@ -79,12 +182,238 @@ func WithDisableMarshalAdditionalData(v bool) Option {
 func WithDisableUnmarshalAdditionalData(v bool) Option {
 	return func(o *Options) { o.DisableUnmarshalAdditionalData = v }
 }
+func WithCacheObserver(fn func(CacheEvent)) Option { return func(o *Options) { o.CacheObserver = fn } }
+func WithNullAware(v bool) Option                  { return func(o *Options) { o.NullAware = v } }
+func WithEmptyStringAsNull(v bool) Option          { return func(o *Options) { o.EmptyStringAsNull = v } }
+func WithRollbackOnCancel(v bool) Option           { return func(o *Options) { o.RollbackOnCancel = v } }
+func WithImplicitSnakeCaseJSON(v bool) Option {
+	return func(o *Options) { o.ImplicitSnakeCaseJSON = v }
+}
+func WithEmptyAdditionalData(style EmptyAdditionalData) Option {
+	return func(o *Options) { o.EmptyAdditionalData = style }
+}
+func WithAllowImplicitConvert(v bool) Option { return func(o *Options) { o.AllowImplicitConvert = v } }
+func WithErrorOnUnmappedSource(v bool) Option {
+	return func(o *Options) { o.ErrorOnUnmappedSource = v }
+}
+func WithOnFieldSkipped(fn FieldSkippedFunc) Option {
+	return func(o *Options) { o.OnFieldSkipped = fn }
+}
+func WithLowercaseAdditionalDataKeys(v bool) Option {
+	return func(o *Options) { o.LowercaseAdditionalDataKeys = v }
+}
+func WithIncludeFields(names ...string) Option {
+	return func(o *Options) { o.IncludeFields = append(o.IncludeFields, names...) }
+}
+func WithExcludeFields(names ...string) Option {
+	return func(o *Options) { o.ExcludeFields = append(o.ExcludeFields, names...) }
+}
+func WithCoerceStringNumbers(v bool) Option { return func(o *Options) { o.CoerceStringNumbers = v } }
+func WithAdditionalDataPreprocessor(fn PreprocessFunc) Option {
+	return func(o *Options) { o.AdditionalDataPreprocessor = fn }
+}
+func WithMergeAdditionalData(v bool) Option   { return func(o *Options) { o.MergeAdditionalData = v } }
+func WithMergeKeepExisting(v bool) Option     { return func(o *Options) { o.MergeKeepExisting = v } }
+func WithNestedStructs(v bool) Option         { return func(o *Options) { o.NestedStructs = v } }
+func WithFieldMatcher(fn FieldMatcher) Option { return func(o *Options) { o.FieldMatcher = fn } }
+
+// WithPanicOnBuildError makes the adapter panic as soon as it builds (or reuses) metadata for a malformed
+// struct, e.g. from WarmMetadata at startup, instead of returning the error from Into. Intended for
+// development and tests; never enable it in production.
+func WithPanicOnBuildError(v bool) Option { return func(o *Options) { o.PanicOnBuildError = v } }
+
+// WithRespectJSONDash keeps fields tagged json:"-" out of AdditionalData: they are neither marshaled into
+// destination AdditionalData nor filled from source AdditionalData. Direct field-to-field copies are unaffected.
+func WithRespectJSONDash(v bool) Option { return func(o *Options) { o.RespectJSONDash = v } }
+
+// WithSkipNonObjectAdditionalData ignores source AdditionalData that is valid JSON but not an object
+// (e.g. a legacy `[]` or `"n/a"`) instead of failing with ErrAdditionalDataNotObject. Malformed JSON still fails.
+func WithSkipNonObjectAdditionalData(v bool) Option {
+	return func(o *Options) { o.SkipNonObjectAdditionalData = v }
+}
+
+// WithStructToJSONField copies a matched struct, map, slice or array field (or pointer to one) into a string
+// or []byte field as JSON, and decodes a JSON string or []byte field into such a field, e.g. for a details
+// column stored as text. Malformed JSON fails Into. It applies only when no converter, direct copy,
+// null-aware or nested-struct rule handles the field.
+func WithStructToJSONField(v bool) Option { return func(o *Options) { o.StructToJSONField = v } }
+
+// WithParallelThreshold copies the fields of a plan concurrently when it has more than n fields (0, the
+// default, is always sequential). Goroutine overhead outweighs the copy for plain fields, so this only pays
+// off when fields carry slow converters or validators; measure before enabling it. Plans that write through
+// embedded or flattened pointers, field groups or unexported-field bridges always run sequentially. On an
+// error, fields after the failing one may already have been written.
+func WithParallelThreshold(n int) Option { return func(o *Options) { o.ParallelThreshold = n } }
+
+// WithoutMetadataCache rebuilds struct metadata and field plans on every Into instead of caching them per
+// type, so memory stays bounded when a process adapts an unbounded number of distinct (e.g. generated or
+// anonymous) struct types. This is much slower, as every call pays the full reflection walk the caches
+// normally amortize; only use it where the set of types cannot be bounded. Warm-up calls become no-ops.
+func WithoutMetadataCache() Option { return func(o *Options) { o.DisableMetadataCache = true } }
+
+// WithAdditionalDataKeyTransform rewrites AdditionalData keys in both directions: encode maps a Go field name
+// to the key it is marshaled under, and decode maps a source key back to the field name it fills, matched
+// exactly first and then ignoring case (so acronyms such as ID survive a snake_case round trip). Either may
+// be nil to leave that direction alone. The pair should be inverse to each other; SnakeCaseKey/FromSnakeCaseKey
+// and KebabCaseKey/FromKebabCaseKey are provided. LowercaseAdditionalDataKeys is applied after encode, and
+// adpath= lookups are not decoded.
+func WithAdditionalDataKeyTransform(encode, decode func(string) string) Option {
+	return func(o *Options) { o.AdditionalDataKeyEncode, o.AdditionalDataKeyDecode = encode, decode }
+}
+
+// WithDynamicInterfaceSources adapts a source field declared as an interface (e.g. an event envelope's
+// Payload interface{}) from the value it holds at run time: a value assignable to the destination field is
+// copied, and a struct or *struct is adapted recursively into a struct or *struct destination. A nil interface
+// resets the destination to its zero value; any other dynamic type is skipped like an incompatible field.
+func WithDynamicInterfaceSources(v bool) Option {
+	return func(o *Options) { o.DynamicInterfaceSources = v }
+}
+
+// WithAdditionalDataFieldName treats an untagged field with this exact Go name (e.g. "Extras") as
+// AdditionalData, like a field named AdditionalData, for structs that cannot be tagged. Its type must still
+// be null.JSON or types.JSON (or a pointer to one). A struct with several such fields uses the first.
+func WithAdditionalDataFieldName(name string) Option {
+	return func(o *Options) { o.AdditionalDataFieldName = name }
+}
+
+// WithTimeLayout converts every matched string field into a time.Time or null.Time field with
+// time.Parse(parseLayout, ...), and every time.Time or null.Time field into a string field with
+// Format(formatLayout), so date-heavy models need no per-field wiring. An empty string adapts to the zero
+// time (an invalid null.Time) and back. Converters registered for a field take precedence. Pass "" for a
+// layout to leave that direction alone.
+func WithTimeLayout(parseLayout, formatLayout string) Option {
+	return func(o *Options) { o.TimeParseLayout, o.TimeFormatLayout = parseLayout, formatLayout }
+}
+
+// WithClearDestinationAdditionalData resets the destination AdditionalData to its zero value (an invalid
+// null.JSON, empty types.JSON or nil pointer) before any field is adapted, so data the caller left in it never
+// survives, even with WithDisableMarshalAdditionalData. With WithMergeAdditionalData there is then nothing to
+// merge into. In-place adaptation (Into(p, p)) still leaves AdditionalData untouched.
+func WithClearDestinationAdditionalData(v bool) Option {
+	return func(o *Options) { o.ClearDestinationAdditionalData = v }
+}
+
+// WithAutoPointers copies between a pointer field and a value field of its element type, for DTOs using
+// *string, *int, ... for optional fields: a nil source sets the zero value, a non-nil one its element, and a
+// value source is copied into a newly allocated pointer. Pointers to convertible types (*int -> *int64) are
+// handled the same way. It applies only when no converter or direct copy handles the field.
+func WithAutoPointers(v bool) Option {
+	return func(o *Options) { o.AutoPointers = v }
+}
+func WithFallbackConverter(fn FallbackConverterFunc) Option {
+	return func(o *Options) { o.FallbackConverter = fn }
+}
+
+// CacheKind identifies which internal cache a CacheEvent refers to.
+type CacheKind int
+
+const (
+	MetadataCache CacheKind = iota // per-type struct metadata
+	PlanCache                      // per (src,dst) build plan
+)
+
+// CacheEvent describes a single cache lookup reported to a CacheObserver.
+// For MetadataCache events only Type is set; for PlanCache events SrcType and DstType are set.
+// Gen is the adapter registry generation at the time of the lookup.
+type CacheEvent struct {
+	Cache   CacheKind
+	Hit     bool
+	Type    reflect.Type
+	SrcType reflect.Type
+	DstType reflect.Type
+	Gen     uint64
+}
 
 // converterRegistry stores converters at multiple scopes and is swapped atomically (copy-on-write)
 type converterRegistry struct {
-	global map[string]ConverterFunc
-	byDst  map[reflect.Type]map[string]ConverterFunc
-	byPair map[[2]reflect.Type]map[string]ConverterFunc // [srcType, dstType]
+	global   map[string]ConverterFunc
+	byDst    map[reflect.Type]map[string]ConverterFunc
+	byPair   map[[2]reflect.Type]map[string]ConverterFunc // [srcType, dstType]
+	byJSON   map[string]ConverterFunc                     // keyed by json tag name; consulted after Go-name scopes
+	enums    map[string]*enumMapping                      // string<->int mappings by field name; resolved per plan
+	toAD     map[string]MarshalTransformFunc              // transforms for source fields marshaled into AdditionalData
+	adConv   map[string]ConverterFunc                     // converters for source fields marshaled into AdditionalData; win over toAD
+	ctx      map[string]ContextConverterFunc              // context-aware converters by field name; win over global
+	value    map[string]ValueConverterFunc                // reflect.Value converters by field name; between ctx and global
+	bridge   map[reflect.Type]map[string]fieldBridge      // accessors for unexported fields, by struct type and field name
+	fanout   map[string][]string                          // source field name -> extra destination field names
+	coalesce map[string][]string                          // destination field name -> candidate source field names
+	groups   map[reflect.Type]map[string][]string         // destination type -> group field -> source field names
+}
+
+// clone returns a deep copy of the registry (maps only; funcs are shared) for copy-on-write updates.
+func (r *converterRegistry) clone() *converterRegistry {
+	n := &converterRegistry{
+		global:   make(map[string]ConverterFunc, len(r.global)+1),
+		byDst:    make(map[reflect.Type]map[string]ConverterFunc, len(r.byDst)+1),
+		byPair:   make(map[[2]reflect.Type]map[string]ConverterFunc, len(r.byPair)+1),
+		byJSON:   make(map[string]ConverterFunc, len(r.byJSON)+1),
+		enums:    make(map[string]*enumMapping, len(r.enums)+1),
+		toAD:     make(map[string]MarshalTransformFunc, len(r.toAD)+1),
+		adConv:   make(map[string]ConverterFunc, len(r.adConv)+1),
+		ctx:      make(map[string]ContextConverterFunc, len(r.ctx)+1),
+		value:    make(map[string]ValueConverterFunc, len(r.value)+1),
+		bridge:   make(map[reflect.Type]map[string]fieldBridge, len(r.bridge)+1),
+		fanout:   make(map[string][]string, len(r.fanout)+1),
+		coalesce: make(map[string][]string, len(r.coalesce)+1),
+		groups:   make(map[reflect.Type]map[string][]string, len(r.groups)+1),
+	}
+	for k, v := range r.global {
+		n.global[k] = v
+	}
+	for k, v := range r.byDst {
+		m := make(map[string]ConverterFunc, len(v))
+		for fk, fv := range v {
+			m[fk] = fv
+		}
+		n.byDst[k] = m
+	}
+	for k, v := range r.byPair {
+		m := make(map[string]ConverterFunc, len(v))
+		for fk, fv := range v {
+			m[fk] = fv
+		}
+		n.byPair[k] = m
+	}
+	for k, v := range r.byJSON {
+		n.byJSON[k] = v
+	}
+	for k, v := range r.enums {
+		n.enums[k] = v
+	}
+	for k, v := range r.toAD {
+		n.toAD[k] = v
+	}
+	for k, v := range r.adConv {
+		n.adConv[k] = v
+	}
+	for k, v := range r.ctx {
+		n.ctx[k] = v
+	}
+	for k, v := range r.value {
+		n.value[k] = v
+	}
+	for k, v := range r.bridge {
+		m := make(map[string]fieldBridge, len(v))
+		for fk, fv := range v {
+			m[fk] = fv
+		}
+		n.bridge[k] = m
+	}
+	for k, v := range r.fanout {
+		n.fanout[k] = v
+	}
+	for k, v := range r.coalesce {
+		n.coalesce[k] = v
+	}
+	for k, v := range r.groups {
+		m := make(map[string][]string, len(v))
+		for fk, fv := range v {
+			m[fk] = fv
+		}
+		n.groups[k] = m
+	}
+	return n
 }
 
 // ValidatorFunc validates a field value after conversion and assignment candidate.
@@ -96,12 +425,22 @@ type validatorRegistry struct {
 
 type fieldInfo struct {
 	index            []int
+	flat             bool // len(index)==1: reachable with a single Field call
 	name             string
 	jsonName         string
+	impliedJSON      bool // jsonName derived via ImplicitSnakeCaseJSON rather than an explicit tag
 	typ              reflect.Type
 	canSet           bool
 	isAdditionalData bool
 	ignore           bool
+	readonly         bool     // adapter:"readonly": never written as a destination, still read as a source
+	writeonly        bool     // adapter:"writeonly": never read as a source, still written as a destination
+	adPath           []string // adapter:"adpath=a.b": filled from this nested AdditionalData path instead of its own key
+	jsonDash         bool     // json:"-": with RespectJSONDash, never marshaled into or filled from AdditionalData
+	omitEmpty        bool     // adapter:"additional,omitempty": AdditionalData left untouched when nothing remains to marshal
+	includeZero      bool     // adapter:"includezero": marshaled into AdditionalData even when zero
+	omitZero         bool     // adapter:"omitzero": left out of AdditionalData when zero, even with IncludeZeroValues
+	tag              reflect.StructTag
 }
 
 type structMetadata struct {
@@ -111,15 +450,58 @@ type structMetadata struct {
 	fieldsByLowerName     map[string]*fieldInfo
 	fieldsByLowerJSONName map[string]*fieldInfo
 	additionalDataField   *fieldInfo
+	adPathFields          []*fieldInfo // fields with an adpath tag, filled from nested AdditionalData keys
+	buildErr              error        // structural problem detected while building metadata; surfaced by Into
+}
+
+// fail records a structural problem found while building metadata. Only the first error is kept; it is
+// cached with the metadata so every later use of the type reports the same error without re-validating.
+func (m *structMetadata) fail(err error) {
+	if m.buildErr == nil {
+		m.buildErr = err
+	}
+}
+
+// lookupKey finds the field named key by Go name, then json name, optionally ignoring case.
+func (m *structMetadata) lookupKey(key string, insensitive bool) *fieldInfo {
+	if !insensitive {
+		if fi, ok := m.fieldsByName[key]; ok {
+			return fi
+		}
+		return m.fieldsByJSONName[key]
+	}
+	lk := strings.ToLower(key)
+	if fi, ok := m.fieldsByLowerName[lk]; ok {
+		return fi
+	}
+	return m.fieldsByLowerJSONName[lk]
+}
+
+// metadataErr returns the first cached build error among metas, checking them in order.
+func metadataErr(metas ...*structMetadata) error {
+	for _, m := range metas {
+		if m.buildErr != nil {
+			return m.buildErr
+		}
+	}
+	return nil
 }
 
 type fieldPlan struct {
 	_dstIndex []int
 	_srcIndex []int
+	_dstFlat  bool
+	_srcFlat  bool
 	_srcName  string
 	_dstName  string
 	conv      ConverterFunc
+	ctxConv   ContextConverterFunc // set instead of conv for context-aware converters
+	valConv   ValueConverterFunc   // set instead of conv for reflect.Value converters
+	convScope string               // registry scope conv or ctxConv was resolved from ("pair", "dst", ..., "time"); for PlanFingerprint
 	val       ValidatorFunc
+	get       func(interface{}) interface{}  // unexported source field bridge; _srcIndex unused when set
+	set       func(interface{}, interface{}) // unexported destination field bridge; _dstIndex unused when set
+	alts      []fieldPlan                    // RegisterCoalesce candidates tried after this entry's own source
 }
 
 type buildPlan struct {
@@ -131,6 +513,16 @@ type buildPlan struct {
 	dstHasAD   bool
 	srcADIndex []int
 	dstADIndex []int
+	structConv []StructConverterFunc
+	// source fields matched to readonly destination fields; consumed without being written so they
+	// do not leak into destination AdditionalData
+	readonlySrc []string
+	// source fields with no destination and no AdditionalData sink; only computed with ErrorOnUnmappedSource
+	unmappedSrc []string
+	// every entry writes a distinct top-level destination field, so entries may run concurrently
+	parallelSafe bool
+	// set when the whole plan is plain assignments between top-level fields (see simpleCopies)
+	simple []simpleCopy
 }
 
 // Adapter performs struct adaptation with optional converters & AdditionalData handling.
@@ -138,29 +530,51 @@ type buildPlan struct {
 type Adapter struct {
 	converters    atomic.Value // holds *converterRegistry
 	validators    atomic.Value // holds *validatorRegistry
+	structConvs   atomic.Value // holds map[reflect.Type][]StructConverterFunc (copy-on-write)
+	factories     atomic.Value // holds map[reflect.Type]func() interface{} (copy-on-write)
 	metadataCache sync.Map     // map[reflect.Type]*structMetadata
-	boolMapPool   sync.Pool    // Pool for map[string]bool reuse
+	scratchPool   sync.Pool    // *scratch reused across calls that touch AdditionalData
 	options       Options
-	gen           atomic.Uint64 // increments on registry changes for plan invalidation
-	planCache     sync.Map      // key: [2]reflect.Type -> *buildPlan (validated against gen)
+	gen           atomic.Uint64   // increments on registry changes for plan invalidation
+	planCache     sync.Map        // key: [2]reflect.Type -> *buildPlan (validated against gen)
+	nullTypes     sync.Map        // map[reflect.Type]nullTypeInfo (or false when not a null wrapper)
+	include       map[string]bool // from Options.IncludeFields; nil means all fields
+	exclude       map[string]bool // from Options.ExcludeFields
 }
 
 // New creates an Adapter with default options.
 func New() *Adapter { return NewWithOptions() }
 
+// NewStrict creates an Adapter for strict module boundaries: only fields whose types are assignable are
+// copied (no implicit conversion), AdditionalData is neither marshaled nor unmarshaled, and Into fails on any
+// source field that would be dropped, either because no destination field matches it or because the types
+// differ. Registered converters still apply. opts are applied after the strict presets.
+func NewStrict(opts ...Option) *Adapter {
+	return NewWithOptions(append([]Option{
+		WithAllowImplicitConvert(false),
+		WithErrorOnUnmappedSource(true),
+		WithDisableMarshalAdditionalData(true),
+		WithDisableUnmarshalAdditionalData(true),
+	}, opts...)...)
+}
+
 // NewWithOptions creates a new Adapter with provided options.
 func NewWithOptions(opts ...Option) *Adapter {
 	a := &Adapter{}
-	optsState := Options{IncludeZeroValues: false, CaseInsensitiveAdditionalData: false, OverwritePolicy: PreferFields}
+	optsState := Options{IncludeZeroValues: false, CaseInsensitiveAdditionalData: false, OverwritePolicy: PreferFields, AllowImplicitConvert: true}
 	for _, f := range opts {
 		f(&optsState)
 	}
 	a.options = optsState
-	reg := &converterRegistry{global: make(map[string]ConverterFunc), byDst: make(map[reflect.Type]map[string]ConverterFunc), byPair: make(map[[2]reflect.Type]map[string]ConverterFunc)}
+	a.include = fieldSet(optsState.IncludeFields)
+	a.exclude = fieldSet(optsState.ExcludeFields)
+	reg := &converterRegistry{global: make(map[string]ConverterFunc), byDst: make(map[reflect.Type]map[string]ConverterFunc), byPair: make(map[[2]reflect.Type]map[string]ConverterFunc), byJSON: make(map[string]ConverterFunc), enums: make(map[string]*enumMapping), toAD: make(map[string]MarshalTransformFunc), adConv: make(map[string]ConverterFunc), ctx: make(map[string]ContextConverterFunc), value: make(map[string]ValueConverterFunc), bridge: make(map[reflect.Type]map[string]fieldBridge), fanout: make(map[string][]string), coalesce: make(map[string][]string), groups: make(map[reflect.Type]map[string][]string)}
 	a.converters.Store(reg)
 	vreg := &validatorRegistry{global: make(map[string]ValidatorFunc), byDst: make(map[reflect.Type]map[string]ValidatorFunc), byPair: make(map[[2]reflect.Type]map[string]ValidatorFunc)}
 	a.validators.Store(vreg)
-	a.boolMapPool = sync.Pool{New: func() interface{} { return (map[string]bool)(nil) }}
+	a.structConvs.Store(map[reflect.Type][]StructConverterFunc{})
+	a.factories.Store(map[reflect.Type]func() interface{}{})
+	a.scratchPool = sync.Pool{New: newScratch}
 	// generation starts at 1
 	a.gen.Store(1)
 	return a
@@ -168,29 +582,7 @@ func NewWithOptions(opts ...Option) *Adapter {
 
 // RegisterConverter adds a global field converter (applies to any src/dst containing fieldName).
 func (a *Adapter) RegisterConverter(fieldName string, fn ConverterFunc) {
-	old := a.converters.Load().(*converterRegistry)
-	newReg := &converterRegistry{
-		global: make(map[string]ConverterFunc, len(old.global)+1),
-		byDst:  make(map[reflect.Type]map[string]ConverterFunc, len(old.byDst)),
-		byPair: make(map[[2]reflect.Type]map[string]ConverterFunc, len(old.byPair)),
-	}
-	for k, v := range old.global {
-		newReg.global[k] = v
-	}
-	for k, v := range old.byDst {
-		m := make(map[string]ConverterFunc, len(v))
-		for fk, fv := range v {
-			m[fk] = fv
-		}
-		newReg.byDst[k] = m
-	}
-	for k, v := range old.byPair {
-		m := make(map[string]ConverterFunc, len(v))
-		for fk, fv := range v {
-			m[fk] = fv
-		}
-		newReg.byPair[k] = m
-	}
+	newReg := a.converters.Load().(*converterRegistry).clone()
 	newReg.global[fieldName] = fn
 	a.converters.Store(newReg)
 	a.gen.Add(1)
@@ -198,30 +590,14 @@ func (a *Adapter) RegisterConverter(fieldName string, fn ConverterFunc) {
 
 // RegisterConverterFor scope: destination type + fieldName.
 func (a *Adapter) RegisterConverterFor(dstType any, fieldName string, fn ConverterFunc) {
-	old := a.converters.Load().(*converterRegistry)
-	newReg := &converterRegistry{
-		global: make(map[string]ConverterFunc, len(old.global)),
-		byDst:  make(map[reflect.Type]map[string]ConverterFunc, len(old.byDst)+1),
-		byPair: make(map[[2]reflect.Type]map[string]ConverterFunc, len(old.byPair)),
-	}
-	for k, v := range old.global {
-		newReg.global[k] = v
-	}
-	for k, v := range old.byDst {
-		m := make(map[string]ConverterFunc, len(v))
-		for fk, fv := range v {
-			m[fk] = fv
-		}
-		newReg.byDst[k] = m
-	}
-	for k, v := range old.byPair {
-		m := make(map[string]ConverterFunc, len(v))
-		for fk, fv := range v {
-			m[fk] = fv
-		}
-		newReg.byPair[k] = m
-	}
-	dt := reflect.TypeOf(dstType)
+	a.RegisterConverterForType(reflect.TypeOf(dstType), fieldName, fn)
+}
+
+// RegisterConverterForType is RegisterConverterFor for callers holding a reflect.Type; a pointer type is
+// treated as its element type.
+func (a *Adapter) RegisterConverterForType(dstType reflect.Type, fieldName string, fn ConverterFunc) {
+	newReg := a.converters.Load().(*converterRegistry).clone()
+	dt := dstType
 	if dt.Kind() == reflect.Ptr {
 		dt = dt.Elem()
 	}
@@ -237,34 +613,18 @@ func (a *Adapter) RegisterConverterFor(dstType any, fieldName string, fn Convert
 
 // RegisterConverterForPair scope: (srcType,dstType)+fieldName highest precedence.
 func (a *Adapter) RegisterConverterForPair(srcType, dstType any, fieldName string, fn ConverterFunc) {
-	old := a.converters.Load().(*converterRegistry)
-	newReg := &converterRegistry{
-		global: make(map[string]ConverterFunc, len(old.global)),
-		byDst:  make(map[reflect.Type]map[string]ConverterFunc, len(old.byDst)),
-		byPair: make(map[[2]reflect.Type]map[string]ConverterFunc, len(old.byPair)+1),
-	}
-	for k, v := range old.global {
-		newReg.global[k] = v
-	}
-	for k, v := range old.byDst {
-		m := make(map[string]ConverterFunc, len(v))
-		for fk, fv := range v {
-			m[fk] = fv
-		}
-		newReg.byDst[k] = m
-	}
-	for k, v := range old.byPair {
-		m := make(map[string]ConverterFunc, len(v))
-		for fk, fv := range v {
-			m[fk] = fv
-		}
-		newReg.byPair[k] = m
-	}
-	st := reflect.TypeOf(srcType)
+	a.RegisterConverterForPairTypes(reflect.TypeOf(srcType), reflect.TypeOf(dstType), fieldName, fn)
+}
+
+// RegisterConverterForPairTypes is RegisterConverterForPair for callers holding reflect.Types; pointer types
+// are treated as their element types.
+func (a *Adapter) RegisterConverterForPairTypes(srcType, dstType reflect.Type, fieldName string, fn ConverterFunc) {
+	newReg := a.converters.Load().(*converterRegistry).clone()
+	st := srcType
 	if st.Kind() == reflect.Ptr {
 		st = st.Elem()
 	}
-	dt := reflect.TypeOf(dstType)
+	dt := dstType
 	if dt.Kind() == reflect.Ptr {
 		dt = dt.Elem()
 	}
@@ -279,6 +639,54 @@ func (a *Adapter) RegisterConverterForPair(srcType, dstType any, fieldName strin
 	a.gen.Add(1)
 }
 
+// RegisterConverterByJSON adds a converter keyed by json tag name. It applies to a destination field whose
+// json name matches when no Go-name converter (pair, destination or global) is registered for that field.
+func (a *Adapter) RegisterConverterByJSON(jsonName string, fn ConverterFunc) {
+	newReg := a.converters.Load().(*converterRegistry).clone()
+	newReg.byJSON[jsonName] = fn
+	a.converters.Store(newReg)
+	a.gen.Add(1)
+}
+
+// RegisterMarshalTransform adds a transform applied to a source field's value before it is stored in
+// destination AdditionalData (e.g. to redact it). Transforms run only for fields that actually end up in
+// AdditionalData; fields copied to a destination field are unaffected.
+func (a *Adapter) RegisterMarshalTransform(fieldName string, fn MarshalTransformFunc) {
+	newReg := a.converters.Load().(*converterRegistry).clone()
+	newReg.toAD[fieldName] = fn
+	a.converters.Store(newReg)
+	a.gen.Add(1)
+}
+
+// RegisterAdditionalDataMarshalConverter adds a converter applied to a source field's value before it is
+// stored in destination AdditionalData (e.g. time.Time -> RFC3339 string). Unlike a marshal transform it may
+// fail: an error aborts adaptation. When both are registered for a field the converter is used and the
+// transform is ignored. Fields copied to a destination field are unaffected.
+func (a *Adapter) RegisterAdditionalDataMarshalConverter(fieldName string, fn ConverterFunc) {
+	newReg := a.converters.Load().(*converterRegistry).clone()
+	newReg.adConv[fieldName] = fn
+	a.converters.Store(newReg)
+	a.gen.Add(1)
+}
+
+// RegisterStructConverter adds a whole-struct converter for a destination type. It runs after field-level
+// adaptation (including AdditionalData handling) and receives pointers to dst and src. Multiple converters
+// for the same type run in registration order.
+func (a *Adapter) RegisterStructConverter(dstType any, fn StructConverterFunc) {
+	dt := reflect.TypeOf(dstType)
+	if dt.Kind() == reflect.Ptr {
+		dt = dt.Elem()
+	}
+	old := a.structConvs.Load().(map[reflect.Type][]StructConverterFunc)
+	m := make(map[reflect.Type][]StructConverterFunc, len(old)+1)
+	for k, v := range old {
+		m[k] = v
+	}
+	m[dt] = append(append([]StructConverterFunc(nil), old[dt]...), fn)
+	a.structConvs.Store(m)
+	a.gen.Add(1)
+}
+
 // RegisterValidator adds a global validator for a field name.
 func (a *Adapter) RegisterValidator(fieldName string, fn ValidatorFunc) {
 	old := a.validators.Load().(*validatorRegistry)
@@ -307,6 +715,12 @@ func (a *Adapter) RegisterValidator(fieldName string, fn ValidatorFunc) {
 
 // RegisterValidatorFor adds a validator scoped to a destination type.
 func (a *Adapter) RegisterValidatorFor(dstType any, fieldName string, fn ValidatorFunc) {
+	a.RegisterValidatorForType(reflect.TypeOf(dstType), fieldName, fn)
+}
+
+// RegisterValidatorForType is RegisterValidatorFor for callers holding a reflect.Type; a pointer type is
+// treated as its element type.
+func (a *Adapter) RegisterValidatorForType(dstType reflect.Type, fieldName string, fn ValidatorFunc) {
 	old := a.validators.Load().(*validatorRegistry)
 	newReg := &validatorRegistry{global: make(map[string]ValidatorFunc, len(old.global)), byDst: make(map[reflect.Type]map[string]ValidatorFunc, len(old.byDst)+1), byPair: make(map[[2]reflect.Type]map[string]ValidatorFunc, len(old.byPair))}
 	for k, v := range old.global {
@@ -326,7 +740,7 @@ func (a *Adapter) RegisterValidatorFor(dstType any, fieldName string, fn Validat
 		}
 		newReg.byPair[k] = m
 	}
-	dt := reflect.TypeOf(dstType)
+	dt := dstType
 	if dt.Kind() == reflect.Ptr {
 		dt = dt.Elem()
 	}
@@ -342,6 +756,12 @@ func (a *Adapter) RegisterValidatorFor(dstType any, fieldName string, fn Validat
 
 // RegisterValidatorForPair adds a validator scoped to (srcType,dstType) for a field name.
 func (a *Adapter) RegisterValidatorForPair(srcType, dstType any, fieldName string, fn ValidatorFunc) {
+	a.RegisterValidatorForPairTypes(reflect.TypeOf(srcType), reflect.TypeOf(dstType), fieldName, fn)
+}
+
+// RegisterValidatorForPairTypes is RegisterValidatorForPair for callers holding reflect.Types; pointer types
+// are treated as their element types.
+func (a *Adapter) RegisterValidatorForPairTypes(srcType, dstType reflect.Type, fieldName string, fn ValidatorFunc) {
 	old := a.validators.Load().(*validatorRegistry)
 	newReg := &validatorRegistry{global: make(map[string]ValidatorFunc, len(old.global)), byDst: make(map[reflect.Type]map[string]ValidatorFunc, len(old.byDst)), byPair: make(map[[2]reflect.Type]map[string]ValidatorFunc, len(old.byPair)+1)}
 	for k, v := range old.global {
@@ -361,11 +781,11 @@ func (a *Adapter) RegisterValidatorForPair(srcType, dstType any, fieldName strin
 		}
 		newReg.byPair[k] = m
 	}
-	st := reflect.TypeOf(srcType)
+	st := srcType
 	if st.Kind() == reflect.Ptr {
 		st = st.Elem()
 	}
-	dt := reflect.TypeOf(dstType)
+	dt := dstType
 	if dt.Kind() == reflect.Ptr {
 		dt = dt.Elem()
 	}
@@ -385,6 +805,7 @@ type RegistryBatch struct {
 	convGlobal map[string]ConverterFunc
 	convDst    map[reflect.Type]map[string]ConverterFunc
 	convPair   map[[2]reflect.Type]map[string]ConverterFunc
+	convJSON   map[string]ConverterFunc
 	valGlobal  map[string]ValidatorFunc
 	valDst     map[reflect.Type]map[string]ValidatorFunc
 	valPair    map[[2]reflect.Type]map[string]ValidatorFunc
@@ -395,34 +816,20 @@ func (a *Adapter) Batch(apply func(*RegistryBatch)) {
 		convGlobal: make(map[string]ConverterFunc),
 		convDst:    make(map[reflect.Type]map[string]ConverterFunc),
 		convPair:   make(map[[2]reflect.Type]map[string]ConverterFunc),
+		convJSON:   make(map[string]ConverterFunc),
 		valGlobal:  make(map[string]ValidatorFunc),
 		valDst:     make(map[reflect.Type]map[string]ValidatorFunc),
 		valPair:    make(map[[2]reflect.Type]map[string]ValidatorFunc),
 	}
 	apply(b)
 	// merge into copies of current registries and swap once
-	oldC := a.converters.Load().(*converterRegistry)
-	newC := &converterRegistry{global: map[string]ConverterFunc{}, byDst: map[reflect.Type]map[string]ConverterFunc{}, byPair: map[[2]reflect.Type]map[string]ConverterFunc{}}
-	for k, v := range oldC.global {
-		newC.global[k] = v
-	}
-	for t, m := range oldC.byDst {
-		sub := map[string]ConverterFunc{}
-		for k, v := range m {
-			sub[k] = v
-		}
-		newC.byDst[t] = sub
-	}
-	for k, m := range oldC.byPair {
-		sub := map[string]ConverterFunc{}
-		for fk, fv := range m {
-			sub[fk] = fv
-		}
-		newC.byPair[k] = sub
-	}
+	newC := a.converters.Load().(*converterRegistry).clone()
 	for k, v := range b.convGlobal {
 		newC.global[k] = v
 	}
+	for k, v := range b.convJSON {
+		newC.byJSON[k] = v
+	}
 	for t, m := range b.convDst {
 		sub := newC.byDst[t]
 		if sub == nil {
@@ -521,7 +928,8 @@ func (b *RegistryBatch) ConverterForPair(src, dst any, field string, fn Converte
 	}
 	m[field] = fn
 }
-func (b *RegistryBatch) GlobalValidator(field string, fn ValidatorFunc) { b.valGlobal[field] = fn }
+func (b *RegistryBatch) ConverterByJSON(jsonName string, fn ConverterFunc) { b.convJSON[jsonName] = fn }
+func (b *RegistryBatch) GlobalValidator(field string, fn ValidatorFunc)    { b.valGlobal[field] = fn }
 func (b *RegistryBatch) ValidatorFor(dst any, field string, fn ValidatorFunc) {
 	dt := reflect.TypeOf(dst)
 	if dt.Kind() == reflect.Ptr {
@@ -557,48 +965,57 @@ func (b *RegistryBatch) ValidatorForPair(src, dst any, field string, fn Validato
 
 // Into performs adaptation from src -> dst; dst,src order for ergonomics
 func (a *Adapter) Into(dst, src interface{}) error {
-	if src == nil || dst == nil {
-		return fmt.Errorf("src and dst must not be nil")
-	}
-
-	srcVal := reflect.ValueOf(src)
-	dstVal := reflect.ValueOf(dst)
+	return a.IntoCtx(context.Background(), dst, src)
+}
 
-	if srcVal.Kind() != reflect.Ptr || dstVal.Kind() != reflect.Ptr {
-		return fmt.Errorf("src and dst must be pointers")
+// IntoValue performs adaptation between reflect.Values holding structs (or pointers to structs).
+// dst must be settable (e.g. obtained via reflect.ValueOf(&x).Elem() or a field of such a value);
+// src only needs to be readable. This allows converters to recurse into nested struct fields.
+func (a *Adapter) IntoValue(dst, src reflect.Value) error {
+	if !src.IsValid() || !dst.IsValid() {
+		return fmt.Errorf("src and dst must be valid values")
 	}
-
-	srcVal = srcVal.Elem()
-	dstVal = dstVal.Elem()
-
-	if srcVal.Kind() != reflect.Struct || dstVal.Kind() != reflect.Struct {
-		return fmt.Errorf("src and dst must point to structs")
+	if src.Kind() == reflect.Ptr {
+		if src.IsNil() {
+			return fmt.Errorf("src must not be nil")
+		}
+		src = src.Elem()
 	}
-
-	return a.adaptStruct(dstVal, srcVal)
-}
-
-// --- metadata helpers ---
-func (a *Adapter) getBoolMap(capHint int) map[string]bool {
-	pooled := a.boolMapPool.Get().(map[string]bool)
-	if pooled == nil {
-		return make(map[string]bool, capHint)
+	if dst.Kind() == reflect.Ptr {
+		if dst.IsNil() {
+			return fmt.Errorf("dst must not be nil")
+		}
+		dst = dst.Elem()
 	}
-	for k := range pooled {
-		delete(pooled, k)
+	if src.Kind() != reflect.Struct || dst.Kind() != reflect.Struct {
+		return fmt.Errorf("src and dst must be structs")
 	}
-	return pooled
-}
-func (a *Adapter) putBoolMap(m map[string]bool) {
-	if m != nil && len(m) <= 128 {
-		a.boolMapPool.Put(m)
+	if !dst.CanSet() {
+		return fmt.Errorf("dst must be settable")
 	}
+	return a.adaptStruct(dst, src)
 }
 
+// --- metadata helpers ---
 func (a *Adapter) getOrBuildMetadata(typ reflect.Type) *structMetadata {
+	if a.options.DisableMetadataCache {
+		return a.checkBuild(a.buildMetadata(typ))
+	}
 	if cached, ok := a.metadataCache.Load(typ); ok {
-		return cached.(*structMetadata)
+		if obs := a.options.CacheObserver; obs != nil {
+			obs(CacheEvent{Cache: MetadataCache, Hit: true, Type: typ, Gen: a.gen.Load()})
+		}
+		return a.checkBuild(cached.(*structMetadata))
 	}
+	if obs := a.options.CacheObserver; obs != nil {
+		obs(CacheEvent{Cache: MetadataCache, Hit: false, Type: typ, Gen: a.gen.Load()})
+	}
+	actual, _ := a.metadataCache.LoadOrStore(typ, a.buildMetadata(typ))
+	return a.checkBuild(actual.(*structMetadata))
+}
+
+// buildMetadata walks typ's fields and indexes them by Go, json and lowercase names.
+func (a *Adapter) buildMetadata(typ reflect.Type) *structMetadata {
 	fc := a.countFields(typ)
 	meta := &structMetadata{
 		fields:                make([]fieldInfo, 0, fc),
@@ -607,24 +1024,50 @@ func (a *Adapter) getOrBuildMetadata(typ reflect.Type) *structMetadata {
 		fieldsByLowerName:     make(map[string]*fieldInfo, fc),
 		fieldsByLowerJSONName: make(map[string]*fieldInfo, fc),
 	}
-	a.buildFieldMetadata(typ, meta, nil)
+	a.buildFieldMetadata(typ, meta, nil, nil, namePrefix{})
 	for i := range meta.fields {
 		fi := &meta.fields[i]
 		meta.fieldsByName[fi.name] = fi
-		if fi.jsonName != "" {
+		if fi.jsonName != "" && !fi.impliedJSON {
+			if prev, dup := meta.fieldsByJSONName[fi.jsonName]; dup {
+				meta.fail(fatal(fmt.Errorf("struct %s: fields %s and %s share json name %q", typ, prev.name, fi.name, fi.jsonName)))
+			}
 			meta.fieldsByJSONName[fi.jsonName] = fi
 		}
 		// precompute lowercase maps for fast case-insensitive lookups
 		meta.fieldsByLowerName[strings.ToLower(fi.name)] = fi
-		if fi.jsonName != "" {
+		if fi.jsonName != "" && !fi.impliedJSON {
 			meta.fieldsByLowerJSONName[strings.ToLower(fi.jsonName)] = fi
 		}
 		if fi.isAdditionalData && meta.additionalDataField == nil {
 			meta.additionalDataField = fi
 		}
+		if fi.adPath != nil {
+			meta.adPathFields = append(meta.adPathFields, fi)
+		}
+	}
+	// implied json names never override explicit tags; a clashing implied name is dropped
+	for i := range meta.fields {
+		fi := &meta.fields[i]
+		if !fi.impliedJSON {
+			continue
+		}
+		if _, taken := meta.fieldsByJSONName[fi.jsonName]; taken {
+			fi.jsonName = ""
+			continue
+		}
+		meta.fieldsByJSONName[fi.jsonName] = fi
+		meta.fieldsByLowerJSONName[strings.ToLower(fi.jsonName)] = fi
+	}
+	return meta
+}
+
+// checkBuild panics with meta's build error when PanicOnBuildError is set.
+func (a *Adapter) checkBuild(meta *structMetadata) *structMetadata {
+	if meta.buildErr != nil && a.options.PanicOnBuildError {
+		panic(meta.buildErr)
 	}
-	actual, _ := a.metadataCache.LoadOrStore(typ, meta)
-	return actual.(*structMetadata)
+	return meta
 }
 
 func (a *Adapter) safeFieldByIndex(val reflect.Value, index []int) (reflect.Value, bool) {
@@ -640,20 +1083,43 @@ func (a *Adapter) safeFieldByIndex(val reflect.Value, index []int) (reflect.Valu
 	return val, true
 }
 
-func (a *Adapter) countFields(typ reflect.Type) int {
+// fieldByIndexAlloc is like safeFieldByIndex but allocates nil embedded (or flattened) pointers along the path.
+// It returns false when a nil pointer cannot be set (e.g. an unexported embedded type).
+func (a *Adapter) fieldByIndexAlloc(val reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && val.Kind() == reflect.Ptr {
+			if val.IsNil() {
+				if !val.CanSet() {
+					return reflect.Value{}, false
+				}
+				val.Set(reflect.New(val.Type().Elem()))
+			}
+			val = val.Elem()
+		}
+		val = val.Field(x)
+	}
+	return val, true
+}
+
+func (a *Adapter) countFields(typ reflect.Type) int { return a.countFieldsIn(typ, nil) }
+
+// countFieldsIn counts flattened fields; visiting holds the struct types on the current embedding path
+// so self-referential embedded pointers are counted as a single field instead of recursed.
+func (a *Adapter) countFieldsIn(typ reflect.Type, visiting []reflect.Type) int {
+	visiting = append(visiting, typ)
 	c := 0
 	for i := 0; i < typ.NumField(); i++ {
 		f := typ.Field(i)
 		if f.PkgPath != "" {
 			continue
 		}
-		if f.Anonymous {
+		if inlined(f) {
 			ft := f.Type
 			if ft.Kind() == reflect.Ptr {
 				ft = ft.Elem()
 			}
-			if ft.Kind() == reflect.Struct {
-				c += a.countFields(ft)
+			if ft.Kind() == reflect.Struct && !containsType(visiting, ft) {
+				c += a.countFieldsIn(ft, visiting)
 				continue
 			}
 		}
@@ -662,17 +1128,24 @@ func (a *Adapter) countFields(typ reflect.Type) int {
 	return c
 }
 
-func (a *Adapter) buildFieldMetadata(typ reflect.Type, meta *structMetadata, prefix []int) {
+// buildFieldMetadata flattens typ's fields into meta. visiting tracks the struct types on the current
+// embedding path; an embedded pointer back to one of them is kept as a regular field to avoid infinite recursion.
+func (a *Adapter) buildFieldMetadata(typ reflect.Type, meta *structMetadata, prefix []int, visiting []reflect.Type, names namePrefix) {
+	visiting = append(visiting, typ)
 	for i := 0; i < typ.NumField(); i++ {
 		f := typ.Field(i)
 		idx := append(append([]int(nil), prefix...), i)
-		if f.Anonymous {
+		if inlined(f) {
 			ft := f.Type
 			if ft.Kind() == reflect.Ptr {
 				ft = ft.Elem()
 			}
-			if ft.Kind() == reflect.Struct {
-				a.buildFieldMetadata(ft, meta, idx)
+			if ft.Kind() == reflect.Struct && !containsType(visiting, ft) {
+				inner := names
+				if p, ok := strings.CutPrefix(f.Tag.Get("adapter"), "prefix="); ok && p != "" {
+					inner = namePrefix{name: names.name + p, json: names.json + toSnakeCase(p) + "_"}
+				}
+				a.buildFieldMetadata(ft, meta, idx, visiting, inner)
 				continue
 			}
 		}
@@ -681,104 +1154,366 @@ func (a *Adapter) buildFieldMetadata(typ reflect.Type, meta *structMetadata, pre
 		}
 		adapterTag := f.Tag.Get("adapter")
 		ignore := adapterTag == "ignore" || adapterTag == "-"
+		readonly := adapterTag == "readonly"
+		writeonly := adapterTag == "writeonly"
+		includeZero := adapterTag == "includezero"
+		omitZero := adapterTag == "omitzero"
+		var adPath []string
+		if p, ok := strings.CutPrefix(adapterTag, "adpath="); ok && p != "" {
+			adPath = strings.Split(p, ".")
+		}
+		name := names.name + f.Name
 		jsonName := ""
+		explicitJSON := false
+		jsonDash := false
 		if jt, ok := f.Tag.Lookup("json"); ok {
+			jsonDash = jt == "-"
 			for j := 0; j < len(jt); j++ {
 				if jt[j] == ',' {
 					jt = jt[:j]
 					break
 				}
 			}
-			if jt != "-" {
-				jsonName = jt
+			if jt != "-" && jt != "" {
+				jsonName = names.json + jt
 			}
+			explicitJSON = jt != ""
+		}
+		impliedJSON := false
+		if !explicitJSON && a.options.ImplicitSnakeCaseJSON {
+			jsonName = toSnakeCase(name)
+			impliedJSON = true
 		}
-		isAD := (adapterTag == "additional") || (f.Name == "AdditionalData")
+		adTag, adOpt, _ := strings.Cut(adapterTag, ",")
+		isAD := adTag == "additional" || f.Name == "AdditionalData" || f.Name == a.options.AdditionalDataFieldName
 		if isAD {
 			// only mark as AdditionalData for supported JSON types
-			isAD = (f.Type == reflect.TypeOf(null.JSON{})) || (f.Type == reflect.TypeOf(boilertypes.JSON{}))
+			isAD = isADType(f.Type)
 		}
-		meta.fields = append(meta.fields, fieldInfo{index: idx, name: f.Name, jsonName: jsonName, typ: f.Type, canSet: true, isAdditionalData: isAD, ignore: ignore})
+		meta.fields = append(meta.fields, fieldInfo{index: idx, flat: len(idx) == 1, name: name, jsonName: jsonName, impliedJSON: impliedJSON, typ: f.Type, canSet: true, isAdditionalData: isAD, ignore: ignore, readonly: readonly, writeonly: writeonly, adPath: adPath, jsonDash: jsonDash, omitEmpty: isAD && adOpt == "omitempty", includeZero: includeZero, omitZero: omitZero, tag: f.Tag})
 	}
 }
 
-// --- core adaptation ---
-func (a *Adapter) adaptStruct(dstVal, srcVal reflect.Value) error {
-	dt := dstVal.Type()
-	st := srcVal.Type()
-	plan := a.getPlan(st, dt)
-	dstMeta := a.getOrBuildMetadata(dt)
-	srcMeta := a.getOrBuildMetadata(st)
-	hasAD := plan.srcHasAD || plan.dstHasAD
-	var processed, dstSet map[string]bool
-	if hasAD {
-		capHint := len(srcMeta.fields)
-		if len(dstMeta.fields) > capHint {
-			capHint = len(dstMeta.fields)
-		}
-		processed = a.getBoolMap(capHint)
-		dstSet = a.getBoolMap(capHint)
-		defer func() { a.putBoolMap(processed); a.putBoolMap(dstSet) }()
-	}
-	for i := range plan.fields {
-		fp := &plan.fields[i]
-		srcField, ok := a.safeFieldByIndex(srcVal, fp._srcIndex)
-		if !ok {
-			continue
+// inlined reports whether f's fields are flattened into the parent: embedded fields, and exported named
+// fields tagged adapter:"flatten". The caller still checks that the type is a struct (or pointer to one).
+func inlined(f reflect.StructField) bool {
+	return f.Anonymous || (f.PkgPath == "" && f.Tag.Get("adapter") == "flatten")
+}
+
+// namePrefix accumulates adapter:"prefix=..." tags of enclosing embedded structs: name is prepended to Go field
+// names and json (the snake_case form plus "_") to json names.
+type namePrefix struct {
+	name string
+	json string
+}
+
+// toSnakeCase converts a Go field name to snake_case, keeping acronyms together (QSODate -> qso_date).
+func toSnakeCase(name string) string {
+	var b strings.Builder
+	b.Grow(len(name) + 4)
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		isUpper := c >= 'A' && c <= 'Z'
+		if isUpper && i > 0 {
+			prev := name[i-1]
+			prevLowerOrDigit := (prev >= 'a' && prev <= 'z') || (prev >= '0' && prev <= '9')
+			nextLower := i+1 < len(name) && name[i+1] >= 'a' && name[i+1] <= 'z'
+			prevUpper := prev >= 'A' && prev <= 'Z'
+			if prevLowerOrDigit || (prevUpper && nextLower) {
+				b.WriteByte('_')
+			}
 		}
-		dstField := dstVal.FieldByIndex(fp._dstIndex)
-		// Apply converter or direct assignment
-		if fp.conv != nil {
-			if err := a.applyConverter(dstField, fp.conv, srcField, fp._dstName); err != nil {
-				return fmt.Errorf("adapting field %s: %w", fp._dstName, err)
+		if isUpper {
+			c += 'a' - 'A'
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+func containsType(types []reflect.Type, t reflect.Type) bool {
+	for _, x := range types {
+		if x == t {
+			return true
+		}
+	}
+	return false
+}
+
+// --- core adaptation ---
+func (a *Adapter) adaptStruct(dstVal, srcVal reflect.Value) error {
+	return a.adaptStructCtx(context.Background(), dstVal, srcVal)
+}
+
+func (a *Adapter) adaptStructCtx(ctx context.Context, dstVal, srcVal reflect.Value) error {
+	dt := dstVal.Type()
+	st := srcVal.Type()
+	dstMeta := a.getOrBuildMetadata(dt)
+	srcMeta := a.getOrBuildMetadata(st)
+	if err := metadataErr(dstMeta, srcMeta); err != nil {
+		return err
+	}
+	return a.runPlan(ctx, dstVal, srcVal, a.getPlan(st, dt), dstMeta, srcMeta)
+}
+
+// adaptInPlace handles Into(p, p): the struct is normalized in place by adapting from a snapshot of itself,
+// so every converter and validator sees the original field values no matter which fields were already
+// rewritten. Fields are processed in a single pass in declaration order. AdditionalData is left untouched,
+// since every other field matches itself and marshaling would otherwise discard its contents.
+func (a *Adapter) adaptInPlace(ctx context.Context, val reflect.Value) error {
+	t := val.Type()
+	meta := a.getOrBuildMetadata(t)
+	if err := metadataErr(meta); err != nil {
+		return err
+	}
+	snapshot := reflect.New(t).Elem()
+	snapshot.Set(val)
+	plan := *a.getPlan(t, t)
+	plan.srcHasAD, plan.dstHasAD = false, false
+	return a.runPlan(ctx, val, snapshot, &plan, meta, meta)
+}
+
+// runPlan executes a resolved plan; metadata for both types must already be validated.
+// ctx is only consulted by context-aware converters.
+func (a *Adapter) runPlan(ctx context.Context, dstVal, srcVal reflect.Value, plan *buildPlan, dstMeta, srcMeta *structMetadata) error {
+	st, dt := plan.srcType, plan.dstType
+	if len(plan.unmappedSrc) > 0 {
+		return adaptError("", fatal(fmt.Errorf("unmapped source fields in %s -> %s: %s", st, dt, strings.Join(plan.unmappedSrc, ", "))))
+	}
+	hasAD := plan.srcHasAD || plan.dstHasAD
+	var sc *scratch
+	var processed, dstSet map[string]bool
+	if hasAD {
+		sc = a.getScratch()
+		defer a.putScratch(sc)
+		processed, dstSet = sc.processed, sc.dstSet
+	}
+	if plan.dstHasAD && a.options.ClearDestinationAdditionalData {
+		dstAD := dstVal.FieldByIndex(plan.dstADIndex)
+		dstAD.Set(reflect.Zero(dstAD.Type()))
+	}
+	rec := claimReport(ctx)
+	if plan.simple != nil && rec == nil {
+		for _, c := range plan.simple {
+			dstVal.Field(c.dst).Set(srcVal.Field(c.src))
+		}
+		return nil
+	}
+	if rec == nil && a.runsParallel(plan) {
+		if err := a.runFieldsParallel(ctx, dstVal, srcVal, plan, processed, dstSet); err != nil {
+			return err // already an AdaptError
+		}
+	} else {
+		for i := range plan.fields {
+			fp := &plan.fields[i]
+			if fp.alts != nil {
+				fp = a.coalesced(fp, srcVal)
 			}
-		} else {
-			srcType := srcField.Type()
-			dstType := dstField.Type()
-			if srcType == dstType || srcType.AssignableTo(dstType) {
-				dstField.Set(srcField)
-			} else if srcType.ConvertibleTo(dstType) {
-				dstField.Set(srcField.Convert(dstType))
-			} else {
-				// skip incompatible types (match previous behavior)
-			}
-		}
-		// Validator
-		if fp.val != nil {
-			if err := fp.val(dstField.Interface()); err != nil {
-				return err
+			outcome, err := a.runField(ctx, fp, dstVal, srcVal)
+			if err != nil {
+				return adaptError(fp._dstName, err)
+			}
+			if outcome != fieldUnreached && hasAD {
+				processed[fp._srcName] = true
+				dstSet[fp._dstName] = true
+			}
+			if rec != nil {
+				rec.field(fp, outcome)
 			}
 		}
-		if hasAD {
-			processed[fp._srcName] = true
-			dstSet[fp._dstName] = true
+	}
+	if hasAD {
+		for _, name := range plan.readonlySrc {
+			processed[name] = true
 		}
 	}
 	if plan.srcHasAD && !a.options.DisableUnmarshalAdditionalData {
 		srcAD := srcVal.FieldByIndex(plan.srcADIndex)
-		if err := a.unmarshalAdditionalData(dstVal, dstMeta, srcAD, dstSet); err != nil {
-			return fmt.Errorf("unmarshaling AdditionalData: %w", err)
+		if err := a.unmarshalAdditionalData(dstVal, dstMeta, srcAD, sc); err != nil {
+			return adaptError("", fmt.Errorf("unmarshaling AdditionalData: %w", err))
 		}
 	}
 	if plan.dstHasAD && !a.options.DisableMarshalAdditionalData {
 		dstAD := dstVal.FieldByIndex(plan.dstADIndex)
-		if err := a.marshalRemainingFields(dstAD, srcVal, st, processed); err != nil {
-			return fmt.Errorf("marshaling remaining fields to AdditionalData: %w", err)
+		var err error
+		if dstAD.Kind() == reflect.Ptr {
+			err = a.marshalRemainingFieldsPtr(dstAD, dstMeta.additionalDataField.omitEmpty, srcVal, st, sc)
+		} else {
+			err = a.marshalRemainingFields(dstAD, dstMeta.additionalDataField.omitEmpty, srcVal, st, sc)
+		}
+		if err != nil {
+			return adaptError("", fmt.Errorf("marshaling remaining fields to AdditionalData: %w", err))
+		}
+	}
+	if rec != nil {
+		a.finishReport(rec, plan, srcVal, srcMeta)
+	}
+	if len(plan.structConv) > 0 {
+		srcPtr := addrOf(srcVal)
+		for _, fn := range plan.structConv {
+			if err := fn(dstVal.Addr().Interface(), srcPtr.Interface()); err != nil {
+				return adaptError("", fmt.Errorf("struct converter for %s: %w", dt, err))
+			}
 		}
 	}
 	return nil
 }
 
+// fieldOutcome is what runField did with a planned field.
+type fieldOutcome uint8
+
+const (
+	fieldUnreached fieldOutcome = iota // a nil embedded pointer on either side; nothing was read or written
+	fieldCopied                        // assigned directly or by an implicit rule (convertible, null-aware, nested, ...)
+	fieldConverted                     // assigned by the plan's converter
+	fieldFallback                      // assigned by the FallbackConverter
+	fieldSkipped                       // incompatible types; the destination was left unchanged
+)
+
+// runField copies one planned field from srcVal into dstVal, applying its converter and validator. It reports
+// fieldUnreached without an error when the field could not be reached (a nil embedded pointer on either side).
+func (a *Adapter) runField(ctx context.Context, fp *fieldPlan, dstVal, srcVal reflect.Value) (fieldOutcome, error) {
+	if fp.get != nil || fp.set != nil {
+		if err := a.runBridge(fp, dstVal, srcVal); err != nil {
+			return fieldUnreached, fmt.Errorf("adapting field %s: %w", fp._dstName, err)
+		}
+		if fp.conv != nil {
+			return fieldConverted, nil
+		}
+		return fieldCopied, nil
+	}
+	var srcField, dstField reflect.Value
+	if fp._srcFlat {
+		srcField = srcVal.Field(fp._srcIndex[0])
+	} else {
+		var ok bool
+		if srcField, ok = a.safeFieldByIndex(srcVal, fp._srcIndex); !ok {
+			return fieldUnreached, nil
+		}
+	}
+	if fp._dstFlat {
+		dstField = dstVal.Field(fp._dstIndex[0])
+	} else {
+		var ok bool
+		if dstField, ok = a.fieldByIndexAlloc(dstVal, fp._dstIndex); !ok {
+			return fieldUnreached, nil
+		}
+	}
+	// Apply converter or direct assignment
+	outcome := fieldCopied
+	if fp.ctxConv != nil {
+		outcome = fieldConverted
+		if err := ctx.Err(); err != nil {
+			return fieldUnreached, err
+		}
+		v := srcField.Interface()
+		converted, err := fp.ctxConv(ctx, v)
+		if err != nil {
+			err = converterError(fp._dstName, v, err)
+		} else {
+			err = a.setConverted(dstField, converted, fp._dstName)
+		}
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return fieldUnreached, ctxErr
+			}
+			return fieldUnreached, fmt.Errorf("adapting field %s: %w", fp._dstName, err)
+		}
+	} else if fp.conv != nil {
+		outcome = fieldConverted
+		if err := a.applyConverter(dstField, fp.conv, srcField, fp._dstName); err != nil {
+			return fieldUnreached, fmt.Errorf("adapting field %s: %w", fp._dstName, err)
+		}
+	} else if fp.valConv != nil {
+		outcome = fieldConverted
+		if err := applyValueConverter(dstField, fp.valConv, srcField, fp._dstName); err != nil {
+			return fieldUnreached, fmt.Errorf("adapting field %s: %w", fp._dstName, err)
+		}
+	} else {
+		srcType := srcField.Type()
+		dstType := dstField.Type()
+		if srcType == dstType || srcType.AssignableTo(dstType) {
+			dstField.Set(srcField)
+		} else if a.options.AllowImplicitConvert && isArrayPair(srcType, dstType) {
+			handled, err := assignAggregate(dstField, srcField)
+			if err == nil && !handled {
+				if a.options.NestedStructs && isNestedSlicePair(srcType, dstType) {
+					err = a.assignNestedSlice(ctx, dstField, srcField)
+				} else {
+					outcome, err = a.assignIncompatible(dstField, srcField, fp._dstName)
+				}
+			}
+			if err != nil {
+				return fieldUnreached, fmt.Errorf("adapting field %s: %w", fp._dstName, err)
+			}
+		} else if a.options.AllowImplicitConvert && srcType.ConvertibleTo(dstType) {
+			convertInto(dstField, srcField)
+		} else if a.options.NullAware && a.assignNullAware(dstField, srcField) {
+			// handled by null wrapper unwrapping/wrapping
+		} else if a.options.AutoPointers && a.isPointerPair(srcType, dstType) {
+			assignPointer(dstField, srcField)
+		} else if a.options.NestedStructs && isNestedPair(srcType, dstType) {
+			if err := a.assignNested(ctx, dstField, srcField); err != nil {
+				return fieldUnreached, fmt.Errorf("adapting field %s: %w", fp._dstName, err)
+			}
+		} else if a.options.NestedStructs && isNestedSlicePair(srcType, dstType) {
+			if err := a.assignNestedSlice(ctx, dstField, srcField); err != nil {
+				return fieldUnreached, fmt.Errorf("adapting field %s: %w", fp._dstName, err)
+			}
+		} else if a.options.StructToJSONField && isJSONFieldPair(srcType, dstType) {
+			if err := assignJSONField(dstField, srcField); err != nil {
+				return fieldUnreached, fmt.Errorf("adapting field %s: %w", fp._dstName, err)
+			}
+		} else if a.options.DynamicInterfaceSources && srcType.Kind() == reflect.Interface {
+			var err error
+			if outcome, err = a.assignDynamic(ctx, dstField, srcField, fp._dstName); err != nil {
+				return fieldUnreached, fmt.Errorf("adapting field %s: %w", fp._dstName, err)
+			}
+		} else {
+			var err error
+			if outcome, err = a.assignIncompatible(dstField, srcField, fp._dstName); err != nil {
+				return fieldUnreached, fmt.Errorf("adapting field %s: %w", fp._dstName, err)
+			}
+		}
+	}
+	// Validator
+	if fp.val != nil {
+		if err := fp.val(dstField.Interface()); err != nil {
+			return fieldUnreached, err
+		}
+	}
+	return outcome, nil
+}
+
+// addrOf returns a pointer to v, copying v into a new value when it is not addressable.
+func addrOf(v reflect.Value) reflect.Value {
+	if v.CanAddr() {
+		return v.Addr()
+	}
+	p := reflect.New(v.Type())
+	p.Elem().Set(v)
+	return p
+}
+
 func (a *Adapter) getPlan(st, dt reflect.Type) *buildPlan {
 	key := [2]reflect.Type{st, dt}
+	gen := a.gen.Load()
 	if v, ok := a.planCache.Load(key); ok {
 		p := v.(*buildPlan)
-		if p.gen == a.gen.Load() {
+		if p.gen == gen {
+			if obs := a.options.CacheObserver; obs != nil {
+				obs(CacheEvent{Cache: PlanCache, Hit: true, SrcType: st, DstType: dt, Gen: gen})
+			}
 			return p
 		}
 	}
+	if obs := a.options.CacheObserver; obs != nil {
+		obs(CacheEvent{Cache: PlanCache, Hit: false, SrcType: st, DstType: dt, Gen: gen})
+	}
 	p := a.buildPlan(st, dt)
-	a.planCache.Store(key, p)
+	if !a.options.DisableMetadataCache {
+		a.planCache.Store(key, p)
+	}
 	return p
 }
 
@@ -797,73 +1532,266 @@ func (a *Adapter) buildPlan(st, dt reflect.Type) *buildPlan {
 	if dstMeta.additionalDataField != nil {
 		p.dstADIndex = dstMeta.additionalDataField.index
 	}
+	p.structConv = a.structConvs.Load().(map[reflect.Type][]StructConverterFunc)[dt]
 
 	// Pre-resolve field mappings and converter/validator per precedence
 	for i := range dstMeta.fields {
 		df := &dstMeta.fields[i]
-		if !df.canSet || df.isAdditionalData || df.ignore {
+		if !df.canSet || df.isAdditionalData || df.ignore || a.skipDst(df.name) {
 			continue
 		}
-		// Find matching source field by name or json tag
-		sf, found := srcMeta.fieldsByName[df.name]
-		if !found && df.jsonName != "" {
-			sf, found = srcMeta.fieldsByJSONName[df.jsonName]
+		sf, found := a.matchSource(df, srcMeta)
+		if !found || sf.isAdditionalData || sf.ignore || sf.writeonly {
+			continue
 		}
-		if !found || sf.isAdditionalData || sf.ignore {
+		if df.readonly {
+			p.readonlySrc = append(p.readonlySrc, sf.name)
 			continue
 		}
-		// Resolve converter precedence: pair > dst > global
-		var conv ConverterFunc
-		if m := reg.byPair[[2]reflect.Type{st, dt}]; m != nil {
-			conv = m[df.name]
+		p.fields = append(p.fields, a.planField(reg, vreg, st, dt, df, sf))
+	}
+	if len(reg.fanout) > 0 {
+		a.planFanout(p, reg, vreg, srcMeta, dstMeta)
+	}
+	if len(reg.coalesce) > 0 {
+		a.planCoalesce(p, reg, vreg, srcMeta, dstMeta)
+	}
+	if groups := reg.groups[dt]; len(groups) > 0 {
+		a.planGroups(p, groups, reg, vreg, srcMeta, dstMeta)
+	}
+	if len(reg.bridge[st]) > 0 || len(reg.bridge[dt]) > 0 {
+		a.planBridges(p, reg, vreg, srcMeta, dstMeta)
+	}
+	if a.options.ErrorOnUnmappedSource && !(p.dstHasAD && !a.options.DisableMarshalAdditionalData) {
+		p.unmappedSrc = unmappedSources(p, srcMeta)
+	}
+	if a.options.ParallelThreshold > 0 {
+		p.parallelSafe = parallelSafe(p.fields)
+	}
+	p.simple = simpleCopies(p)
+	return p
+}
+
+// simpleCopy is one plain assignment of a top-level source field to a top-level destination field.
+type simpleCopy struct{ src, dst int }
+
+// simpleCopies returns the plan as plain assignments when runPlan can skip all per-field work: no
+// AdditionalData on either side, no struct converters, no unmapped-source error, and every entry copies
+// an assignable top-level field with no converter, validator or bridge. Otherwise it returns nil. Plans are
+// rebuilt when the registry generation changes, so a later registration drops the fast path.
+func simpleCopies(p *buildPlan) []simpleCopy {
+	if p.srcHasAD || p.dstHasAD || len(p.structConv) > 0 || len(p.unmappedSrc) > 0 {
+		return nil
+	}
+	copies := make([]simpleCopy, 0, len(p.fields))
+	for i := range p.fields {
+		fp := &p.fields[i]
+		if !fp._srcFlat || !fp._dstFlat || fp.conv != nil || fp.ctxConv != nil || fp.valConv != nil || fp.val != nil || fp.get != nil || fp.set != nil || fp.alts != nil {
+			return nil
+		}
+		st, dt := p.srcType.Field(fp._srcIndex[0]).Type, p.dstType.Field(fp._dstIndex[0]).Type
+		if !st.AssignableTo(dt) {
+			return nil
 		}
-		if conv == nil {
-			if m := reg.byDst[dt]; m != nil {
-				conv = m[df.name]
+		copies = append(copies, simpleCopy{src: fp._srcIndex[0], dst: fp._dstIndex[0]})
+	}
+	return copies
+}
+
+// planField resolves the converter and validator for copying sf into df.
+func (a *Adapter) planField(reg *converterRegistry, vreg *validatorRegistry, st, dt reflect.Type, df, sf *fieldInfo) fieldPlan {
+	// Resolve converter precedence: pair > dst > global (context-aware, then reflect.Value, first) > json name
+	var conv ConverterFunc
+	var ctxConv ContextConverterFunc
+	scope := ""
+	if m := reg.byPair[[2]reflect.Type{st, dt}]; m != nil {
+		if conv = m[df.name]; conv != nil {
+			scope = "pair"
+		}
+	}
+	if conv == nil {
+		if m := reg.byDst[dt]; m != nil {
+			if conv = m[df.name]; conv != nil {
+				scope = "dst"
 			}
 		}
-		if conv == nil {
-			conv = reg.global[df.name]
+	}
+	if conv == nil {
+		if ctxConv = reg.ctx[df.name]; ctxConv != nil {
+			scope = "context"
 		}
-		// Resolve validator precedence in same order
-		var val ValidatorFunc
-		if m := vreg.byPair[[2]reflect.Type{st, dt}]; m != nil {
-			val = m[df.name]
+	}
+	var valConv ValueConverterFunc
+	if conv == nil && ctxConv == nil {
+		if valConv = reg.value[df.name]; valConv != nil {
+			scope = "value"
 		}
-		if val == nil {
-			if m := vreg.byDst[dt]; m != nil {
-				val = m[df.name]
+	}
+	if conv == nil && ctxConv == nil && valConv == nil {
+		if conv = reg.global[df.name]; conv != nil {
+			scope = "global"
+		}
+	}
+	if conv == nil && ctxConv == nil && valConv == nil && df.jsonName != "" {
+		if conv = reg.byJSON[df.jsonName]; conv != nil {
+			scope = "json"
+		}
+	}
+	if conv == nil && ctxConv == nil && valConv == nil {
+		e := reg.enums[df.name]
+		if e == nil {
+			e = reg.enums[sf.name]
+		}
+		if e != nil {
+			if conv = e.converterFor(df.name, sf.typ, df.typ); conv != nil {
+				scope = "enum"
 			}
 		}
-		if val == nil {
-			val = vreg.global[df.name]
+	}
+	if conv == nil && ctxConv == nil && valConv == nil {
+		if conv = a.timeLayoutConverter(sf.typ, df.typ); conv != nil {
+			scope = "time"
 		}
-		p.fields = append(p.fields, fieldPlan{_dstIndex: df.index, _srcIndex: sf.index, _srcName: sf.name, _dstName: df.name, conv: conv, val: val})
 	}
-	return p
+	// Resolve validator precedence in same order
+	var val ValidatorFunc
+	if m := vreg.byPair[[2]reflect.Type{st, dt}]; m != nil {
+		val = m[df.name]
+	}
+	if val == nil {
+		if m := vreg.byDst[dt]; m != nil {
+			val = m[df.name]
+		}
+	}
+	if val == nil {
+		val = vreg.global[df.name]
+	}
+	return fieldPlan{_dstIndex: df.index, _srcIndex: sf.index, _dstFlat: df.flat, _srcFlat: sf.flat, _srcName: sf.name, _dstName: df.name, conv: conv, ctxConv: ctxConv, valConv: valConv, convScope: scope, val: val}
+}
+
+// hiddenFromAD reports whether fi is kept out of AdditionalData by RespectJSONDash.
+func (a *Adapter) hiddenFromAD(fi *fieldInfo) bool {
+	return fi.jsonDash && a.options.RespectJSONDash
+}
+
+// skipDst reports whether the destination field name is filtered out by IncludeFields/ExcludeFields.
+func (a *Adapter) skipDst(name string) bool {
+	return a.exclude[name] || (a.include != nil && !a.include[name])
+}
+
+func fieldSet(names []string) map[string]bool {
+	if len(names) == 0 {
+		return nil
+	}
+	m := make(map[string]bool, len(names))
+	for _, n := range names {
+		m[n] = true
+	}
+	return m
+}
+
+// unmappedSources lists source fields the plan neither copies nor consumes.
+func unmappedSources(p *buildPlan, srcMeta *structMetadata) []string {
+	used := make(map[string]bool, len(p.fields)+len(p.readonlySrc))
+	for i := range p.fields {
+		used[p.fields[i]._srcName] = true
+		for _, alt := range p.fields[i].alts {
+			used[alt._srcName] = true
+		}
+	}
+	for _, name := range p.readonlySrc {
+		used[name] = true
+	}
+	var out []string
+	for i := range srcMeta.fields {
+		sf := &srcMeta.fields[i]
+		if sf.isAdditionalData || sf.ignore || sf.writeonly || used[sf.name] {
+			continue
+		}
+		out = append(out, sf.name)
+	}
+	return out
 }
 
 // --- converter/validator application ---
 func (a *Adapter) applyConverter(dstField reflect.Value, fn ConverterFunc, srcField reflect.Value, fieldName string) error {
-	converted, err := fn(srcField.Interface())
+	v := srcField.Interface()
+	converted, err := fn(v)
 	if err != nil {
-		return err
+		return converterError(fieldName, v, err)
 	}
+	return a.setConverted(dstField, converted, fieldName)
+}
+
+// maxRenderedValue bounds how much of an offending source value is quoted in converter errors.
+const maxRenderedValue = 64
+
+// converterError wraps a converter failure with the offending value and its type, e.g.
+// `converter for Freq failed on "not-a-freq" (string): ...`. Strings are quoted; other values use %v. The
+// rendered value is truncated to maxRenderedValue runes.
+func converterError(fieldName string, v interface{}, err error) error {
+	str, isString := v.(string)
+	if !isString {
+		str = fmt.Sprintf("%v", v)
+	}
+	if len(str) > maxRenderedValue {
+		if r := []rune(str); len(r) > maxRenderedValue {
+			str = string(r[:maxRenderedValue]) + "..."
+		}
+	}
+	if isString {
+		str = strconv.Quote(str)
+	}
+	return fmt.Errorf("converter for %s failed on %s (%T): %w", fieldName, str, v, err)
+}
+
+// setConverted assigns a converter result to dstField; nil resets the field to its zero value.
+func (a *Adapter) setConverted(dstField reflect.Value, converted interface{}, fieldName string) error {
 	if converted == nil {
 		dstField.Set(reflect.Zero(dstField.Type()))
 		return nil
 	}
 	cv := reflect.ValueOf(converted)
 	if !cv.IsValid() {
-		return fmt.Errorf("converter returned invalid value for field %s", fieldName)
+		return fatal(fmt.Errorf("converter returned invalid value for field %s", fieldName))
 	}
 	if !cv.Type().AssignableTo(dstField.Type()) {
-		return fmt.Errorf("converter returned type %s, expected %s", cv.Type(), dstField.Type())
+		return fatal(fmt.Errorf("converter returned type %s, expected %s", cv.Type(), dstField.Type()))
 	}
 	dstField.Set(cv)
 	return nil
 }
 
+// assignIncompatible handles a matched field no direct rule could assign: the FallbackConverter runs if
+// set; otherwise the field is skipped, or reported when ErrorOnUnmappedSource is set. Skipped fields are
+// reported to OnFieldSkipped.
+func (a *Adapter) assignIncompatible(dstField, srcField reflect.Value, fieldName string) (fieldOutcome, error) {
+	fb := a.options.FallbackConverter
+	if fb == nil {
+		if a.options.ErrorOnUnmappedSource {
+			return fieldUnreached, fatal(fmt.Errorf("cannot assign %s to %s", srcField.Type(), dstField.Type()))
+		}
+		a.fieldSkipped(fieldName, srcField.Type(), dstField.Type())
+		return fieldSkipped, nil
+	}
+	dstType := dstField.Type()
+	err := a.applyConverter(dstField, func(v interface{}) (interface{}, error) { return fb(v, dstType) }, srcField, fieldName)
+	if errors.Is(err, Skip) {
+		a.fieldSkipped(fieldName, srcField.Type(), dstType)
+		return fieldSkipped, nil
+	}
+	if err != nil {
+		return fieldUnreached, err
+	}
+	return fieldFallback, nil
+}
+
+func (a *Adapter) fieldSkipped(fieldName string, srcType, dstType reflect.Type) {
+	if fn := a.options.OnFieldSkipped; fn != nil {
+		fn(fieldName, srcType, dstType)
+	}
+}
+
 // WarmMetadata pre-builds metadata for provided example values or types.
 func (a *Adapter) WarmMetadata(examples ...any) {
 	for _, e := range examples {
@@ -882,7 +1810,42 @@ func (a *Adapter) WarmMetadata(examples ...any) {
 	}
 }
 
-func (a *Adapter) unmarshalAdditionalData(dstVal reflect.Value, dstMeta *structMetadata, srcAdditionalData reflect.Value, dstFieldsSet map[string]bool) error {
+// WarmPair pre-builds metadata and the build plan for adapting src into dst (example values or pointers), so
+// the first Into for the pair skips plan construction. Non-struct arguments are ignored. Registrations made
+// afterwards invalidate the plan as usual.
+func (a *Adapter) WarmPair(src, dst any) {
+	st, dt := structType(src), structType(dst)
+	if st == nil || dt == nil {
+		return
+	}
+	if metadataErr(a.getOrBuildMetadata(st), a.getOrBuildMetadata(dt)) != nil {
+		return
+	}
+	a.getPlan(st, dt)
+}
+
+// structType returns the struct type of an example value or pointer, or nil.
+func structType(v any) reflect.Type {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return nil
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	return t
+}
+
+func (a *Adapter) unmarshalAdditionalData(dstVal reflect.Value, dstMeta *structMetadata, srcAdditionalData reflect.Value, sc *scratch) error {
+	if srcAdditionalData.Kind() == reflect.Ptr {
+		if srcAdditionalData.IsNil() {
+			return nil
+		}
+		srcAdditionalData = srcAdditionalData.Elem()
+	}
 	var rawBytes []byte
 	if nj, ok := srcAdditionalData.Interface().(null.JSON); ok {
 		if !nj.Valid {
@@ -890,123 +1853,413 @@ func (a *Adapter) unmarshalAdditionalData(dstVal reflect.Value, dstMeta *structM
 		}
 		rawBytes = nj.JSON
 	} else if bj, ok := srcAdditionalData.Interface().(boilertypes.JSON); ok {
-		if len(bj) == 0 {
-			return nil
-		}
 		rawBytes = bj
 	} else {
 		return nil
 	}
-	var fields map[string]json.RawMessage
+	if isNoData(rawBytes) {
+		return nil
+	}
+	if pre := a.options.AdditionalDataPreprocessor; pre != nil {
+		var err error
+		if rawBytes, err = pre(rawBytes); err != nil {
+			return fmt.Errorf("preprocessing: %w", err)
+		}
+		if isNoData(rawBytes) {
+			return nil
+		}
+	}
+	if sc.adIn == nil {
+		sc.adIn = make(map[string]json.RawMessage)
+	}
+	fields, dstFieldsSet := sc.adIn, sc.dstSet
 	if err := json.Unmarshal(rawBytes, &fields); err != nil {
-		return err
+		kind := jsonKind(rawBytes)
+		if kind == "" || !json.Valid(rawBytes) {
+			return fmt.Errorf("invalid JSON: %w", err)
+		}
+		if a.options.SkipNonObjectAdditionalData {
+			return nil
+		}
+		return fmt.Errorf("%w: got %s", ErrAdditionalDataNotObject, kind)
 	}
 	reg := a.converters.Load().(*converterRegistry)
 	lookupInsensitive := a.options.CaseInsensitiveAdditionalData
-	lookup := func(key string) (*fieldInfo, bool, string) {
-		if !lookupInsensitive {
-			if fi, ok := dstMeta.fieldsByName[key]; ok {
-				return fi, true, fi.name
-			}
-			if fi, ok := dstMeta.fieldsByJSONName[key]; ok {
-				return fi, true, fi.name
+	decode := a.options.AdditionalDataKeyDecode
+	for k, raw := range fields {
+		var fi *fieldInfo
+		if decode != nil {
+			name := decode(k)
+			if fi = dstMeta.lookupKey(name, lookupInsensitive); fi == nil && !lookupInsensitive {
+				fi = dstMeta.lookupKey(name, true)
 			}
-			return nil, false, ""
+		} else {
+			fi = dstMeta.lookupKey(k, lookupInsensitive)
 		}
-		lk := strings.ToLower(key)
-		if fi, ok := dstMeta.fieldsByLowerName[lk]; ok {
-			return fi, true, fi.name
+		if fi == nil || fi.adPath != nil {
+			continue
 		}
-		if fi, ok := dstMeta.fieldsByLowerJSONName[lk]; ok {
-			return fi, true, fi.name
+		if err := a.setFromAdditionalData(dstVal, fi, fi.name, raw, reg, dstFieldsSet); err != nil {
+			return err
 		}
-		return nil, false, ""
 	}
-	for k, raw := range fields {
-		fi, ok, canon := lookup(k)
-		if !ok || !fi.canSet || fi.ignore {
+	for _, fi := range dstMeta.adPathFields {
+		raw, ok := lookupADPath(fields, fi.adPath, lookupInsensitive)
+		if !ok {
 			continue
 		}
-		if a.options.OverwritePolicy == PreferFields && dstFieldsSet[canon] {
-			continue
+		if err := a.setFromAdditionalData(dstVal, fi, fi.name, raw, reg, dstFieldsSet); err != nil {
+			return err
 		}
-		dstField := dstVal.FieldByIndex(fi.index)
-		if fn := reg.global[fi.name]; fn != nil { // converter path
-			var anyVal interface{}
-			if err := json.Unmarshal(raw, &anyVal); err == nil {
-				converted, err := fn(anyVal)
-				if err == nil && converted != nil {
-					cv := reflect.ValueOf(converted)
-					if cv.IsValid() && cv.Type().AssignableTo(dstField.Type()) {
-						dstField.Set(cv)
-						if err := a.runValidators(dstField, fi.name, reflect.TypeOf(struct{}{}), dstVal.Type()); err != nil {
-							return err
-						}
-						dstFieldsSet[canon] = true
-					}
+	}
+	return nil
+}
+
+// isNoData reports whether raw AdditionalData bytes carry no fields: they are empty or the JSON literal null
+// (as stored by WithEmptyAdditionalData(EmptyAsJSONNull) or a driver writing SQL JSON null), surrounding
+// whitespace allowed. Such AdditionalData is handled like an invalid null.JSON.
+func isNoData(raw []byte) bool {
+	raw = bytes.TrimSpace(raw)
+	return len(raw) == 0 || string(raw) == "null"
+}
+
+// jsonKind names the kind of JSON value raw starts with for non-object values, or "" for an object or
+// anything unrecognized. It only inspects the first non-space byte; callers validate raw separately.
+func jsonKind(raw []byte) string {
+	for _, c := range raw {
+		switch c {
+		case ' ', '\t', '\n', '\r':
+			continue
+		case '[':
+			return "array"
+		case '"':
+			return "string"
+		case 't', 'f':
+			return "boolean"
+		case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+			return "number"
+		}
+		return ""
+	}
+	return ""
+}
+
+// setFromAdditionalData decodes raw into the destination field fi (via a registered converter if any),
+// honoring write restrictions and the overwrite policy. Values that fail to decode are skipped.
+func (a *Adapter) setFromAdditionalData(dstVal reflect.Value, fi *fieldInfo, canon string, raw json.RawMessage, reg *converterRegistry, dstFieldsSet map[string]bool) error {
+	if !fi.canSet || fi.ignore || fi.readonly || a.skipDst(fi.name) || a.hiddenFromAD(fi) {
+		return nil
+	}
+	if a.options.OverwritePolicy == PreferFields && dstFieldsSet[canon] {
+		return nil
+	}
+	fn := reg.global[fi.name]
+	if fn == nil && fi.jsonName != "" {
+		fn = reg.byJSON[fi.jsonName]
+	}
+	var value reflect.Value
+	if fn != nil { // converter path
+		anyVal, ok := decodeForConverter(raw, fi.typ)
+		if ok {
+			converted, err := fn(anyVal)
+			if err == nil && converted != nil {
+				cv := reflect.ValueOf(converted)
+				if cv.IsValid() && cv.Type().AssignableTo(fi.typ) {
+					value = cv
 				}
 			}
-			// Do not fallback to direct unmarshal when a converter is registered, regardless of outcome
-			continue
 		}
-		ptr := reflect.New(dstField.Type())
-		if err := json.Unmarshal(raw, ptr.Interface()); err != nil {
-			continue
+		// Do not fallback to direct unmarshal when a converter is registered, regardless of outcome
+		if !value.IsValid() {
+			return nil
 		}
-		dstField.Set(ptr.Elem())
-		if err := a.runValidators(dstField, fi.name, reflect.TypeOf(struct{}{}), dstVal.Type()); err != nil {
-			return err
+	} else {
+		ptr := reflect.New(fi.typ)
+		if err := json.Unmarshal(raw, ptr.Interface()); err != nil {
+			if !a.options.CoerceStringNumbers || !coerceStringNumber(ptr.Elem(), raw) {
+				return nil
+			}
 		}
-		dstFieldsSet[canon] = true
+		value = ptr.Elem()
 	}
+	// allocate nil embedded pointers only once we know the key will be written
+	dstField, ok := a.fieldByIndexAlloc(dstVal, fi.index)
+	if !ok {
+		return nil
+	}
+	dstField.Set(value)
+	if err := a.runValidators(dstField, fi.name, reflect.TypeOf(struct{}{}), dstVal.Type()); err != nil {
+		return err
+	}
+	dstFieldsSet[canon] = true
 	return nil
 }
 
-func (a *Adapter) marshalRemainingFields(dstAdditionalData reflect.Value, srcVal reflect.Value, srcType reflect.Type, processed map[string]bool) error {
+// decodeForConverter decodes an AdditionalData value for a converter. Slice, array and map fields receive a
+// value of the field's own type (e.g. []string rather than []interface{}); other fields get the generic
+// decoding of the JSON value.
+func decodeForConverter(raw json.RawMessage, typ reflect.Type) (interface{}, bool) {
+	switch typ.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		ptr := reflect.New(typ)
+		if err := json.Unmarshal(raw, ptr.Interface()); err != nil {
+			return nil, false
+		}
+		return ptr.Elem().Interface(), true
+	}
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, false
+	}
+	return v, true
+}
+
+// coerceStringNumber parses a JSON string holding a number (e.g. "30") into the integer or float value v.
+// It reports false for other kinds, non-string JSON, non-numeric strings and out-of-range numbers.
+func coerceStringNumber(v reflect.Value, raw json.RawMessage) bool {
+	var str string
+	if err := json.Unmarshal(raw, &str); err != nil {
+		return false
+	}
+	str = strings.TrimSpace(str)
+	switch {
+	case isSignedKind(v.Kind()):
+		n, err := strconv.ParseInt(str, 10, v.Type().Bits())
+		if err != nil {
+			return false
+		}
+		v.SetInt(n)
+	case isUnsignedKind(v.Kind()):
+		n, err := strconv.ParseUint(str, 10, v.Type().Bits())
+		if err != nil {
+			return false
+		}
+		v.SetUint(n)
+	case isFloatKind(v.Kind()):
+		f, err := strconv.ParseFloat(str, v.Type().Bits())
+		if err != nil {
+			return false
+		}
+		v.SetFloat(f)
+	default:
+		return false
+	}
+	return true
+}
+
+// decodeExistingAD decodes the current value of a destination AdditionalData field, keeping values raw so
+// they are re-encoded unchanged. Invalid or empty AdditionalData and JSON null decode to a nil map.
+func decodeExistingAD(v reflect.Value) (map[string]json.RawMessage, error) {
+	var raw []byte
+	switch x := v.Interface().(type) {
+	case null.JSON:
+		if !x.Valid {
+			return nil, nil
+		}
+		raw = x.JSON
+	case boilertypes.JSON:
+		raw = x
+	}
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// lookupADPath drills into nested JSON objects following path. It reports false when any intermediate key
+// is missing or is not an object.
+func lookupADPath(fields map[string]json.RawMessage, path []string, insensitive bool) (json.RawMessage, bool) {
+	for i, key := range path {
+		raw, ok := fields[key]
+		if !ok && insensitive {
+			for k, v := range fields {
+				if strings.EqualFold(k, key) {
+					raw, ok = v, true
+					break
+				}
+			}
+		}
+		if !ok {
+			return nil, false
+		}
+		if i == len(path)-1 {
+			return raw, true
+		}
+		fields = nil
+		if err := json.Unmarshal(raw, &fields); err != nil || fields == nil {
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
+// keepZero reports whether a zero value of sf is marshaled into AdditionalData: adapter:"includezero" and
+// adapter:"omitzero" override IncludeZeroValues for the field.
+func (a *Adapter) keepZero(sf *fieldInfo) bool {
+	return sf.includeZero || a.options.IncludeZeroValues && !sf.omitZero
+}
+
+// adCandidate returns sf's value in srcVal when it is marshaled into destination AdditionalData unless a plan
+// entry consumed it: it is not ignored, writeonly or hidden by RespectJSONDash, is reachable, and is non-zero
+// (or its zero value is kept, see droppedAsZero).
+func (a *Adapter) adCandidate(sf *fieldInfo, srcVal reflect.Value) (reflect.Value, bool) {
+	if sf.isAdditionalData || sf.ignore || sf.writeonly || a.hiddenFromAD(sf) {
+		return reflect.Value{}, false
+	}
+	v, ok := a.safeFieldByIndex(srcVal, sf.index)
+	if !ok || !v.CanInterface() || a.droppedAsZero(sf, v) {
+		return reflect.Value{}, false
+	}
+	return v, true
+}
+
+// droppedAsZero reports whether the value v of sf is left out of AdditionalData for being zero. A nil pointer
+// always is: it means absent, whereas a non-nil pointer to a zero value is kept like any non-zero value.
+func (a *Adapter) droppedAsZero(sf *fieldInfo, v reflect.Value) bool {
+	return v.IsZero() && (!a.keepZero(sf) || v.Kind() == reflect.Ptr)
+}
+
+func (a *Adapter) marshalRemainingFields(dstAdditionalData reflect.Value, omitEmpty bool, srcVal reflect.Value, srcType reflect.Type, sc *scratch) error {
+	processed := sc.processed
 	var remaining map[string]interface{}
 	srcMeta := a.getOrBuildMetadata(srcType)
+	reg := a.converters.Load().(*converterRegistry)
 	for i := range srcMeta.fields {
 		sf := &srcMeta.fields[i]
-		if sf.isAdditionalData || sf.ignore {
-			continue
-		}
 		if processed[sf.name] {
 			continue
 		}
-		srcField, ok := a.safeFieldByIndex(srcVal, sf.index)
-		if !ok || !srcField.CanInterface() {
-			continue
-		}
-		if !a.options.IncludeZeroValues && srcField.IsZero() {
+		srcField, ok := a.adCandidate(sf, srcVal)
+		if !ok {
 			continue
 		}
 		if remaining == nil {
-			remaining = make(map[string]interface{})
+			if sc.adOut == nil {
+				sc.adOut = make(map[string]interface{})
+			}
+			remaining = sc.adOut
+		}
+		key := sf.name
+		if enc := a.options.AdditionalDataKeyEncode; enc != nil {
+			key = enc(key)
+		}
+		if a.options.LowercaseAdditionalDataKeys {
+			key = strings.ToLower(key)
+		}
+		if fn := reg.adConv[sf.name]; fn != nil {
+			v, err := fn(srcField.Interface())
+			if err != nil {
+				return fmt.Errorf("converting field %s: %w", sf.name, err)
+			}
+			remaining[key] = v
+		} else if fn := reg.toAD[sf.name]; fn != nil {
+			remaining[key] = fn(srcField.Interface())
+		} else {
+			remaining[key] = srcField.Interface()
+		}
+	}
+	if a.options.MergeAdditionalData {
+		existing, err := decodeExistingAD(dstAdditionalData)
+		if err != nil {
+			return fmt.Errorf("decoding existing AdditionalData: %w", err)
+		}
+		if len(existing) > 0 {
+			if len(remaining) == 0 {
+				return nil // nothing new; keep what is there
+			}
+			merged := make(map[string]interface{}, len(existing)+len(remaining))
+			for k, v := range existing {
+				merged[k] = v
+			}
+			for k, v := range remaining {
+				if _, taken := existing[k]; taken && a.options.MergeKeepExisting {
+					continue
+				}
+				merged[k] = v
+			}
+			remaining = merged
 		}
-		remaining[sf.name] = srcField.Interface()
 	}
 	t := dstAdditionalData.Type()
-	if remaining == nil || len(remaining) == 0 {
-		// set zero values without allocating/marshaling
-		if t == reflect.TypeOf(null.JSON{}) {
-			dstAdditionalData.Set(reflect.ValueOf(null.JSON{}))
-		} else if t == reflect.TypeOf(boilertypes.JSON{}) {
-			dstAdditionalData.Set(reflect.ValueOf(boilertypes.JSON(nil)))
+	var bytes []byte
+	if len(remaining) == 0 {
+		if omitEmpty {
+			return nil
+		}
+		switch a.options.EmptyAdditionalData {
+		case EmptyAsObject:
+			bytes = []byte("{}")
+		case EmptyAsJSONNull:
+			bytes = []byte("null")
+		default:
+			// set zero values without allocating/marshaling
+			if t == reflect.TypeOf(null.JSON{}) {
+				dstAdditionalData.Set(reflect.ValueOf(null.JSON{}))
+			} else if t == reflect.TypeOf(boilertypes.JSON{}) {
+				dstAdditionalData.Set(reflect.ValueOf(boilertypes.JSON(nil)))
+			}
+			return nil
+		}
+	} else {
+		var err error
+		if bytes, err = json.Marshal(remaining); err != nil {
+			return fatal(err)
 		}
-		return nil
 	}
-	bytes, err := json.Marshal(remaining)
-	if err != nil {
+	storeAdditionalData(dstAdditionalData, bytes)
+	return nil
+}
+
+// marshalRemainingFieldsPtr is marshalRemainingFields for a *null.JSON or *types.JSON destination. It works on
+// a copy of the current value and stores a newly allocated one, so a value shared through the pointer is
+// never mutated. An unchanged value keeps the existing pointer, and an empty (SQL NULL) result sets nil.
+func (a *Adapter) marshalRemainingFieldsPtr(dstAdditionalData reflect.Value, omitEmpty bool, srcVal reflect.Value, srcType reflect.Type, sc *scratch) error {
+	v := reflect.New(dstAdditionalData.Type().Elem())
+	if !dstAdditionalData.IsNil() {
+		v.Elem().Set(dstAdditionalData.Elem())
+	}
+	if err := a.marshalRemainingFields(v.Elem(), omitEmpty, srcVal, srcType, sc); err != nil {
 		return err
 	}
-	if t == reflect.TypeOf(null.JSON{}) {
-		dstAdditionalData.Set(reflect.ValueOf(null.JSONFrom(bytes)))
-	} else if t == reflect.TypeOf(boilertypes.JSON{}) {
-		dstAdditionalData.Set(reflect.ValueOf(boilertypes.JSON(bytes)))
+	switch {
+	case !dstAdditionalData.IsNil() && reflect.DeepEqual(v.Elem().Interface(), dstAdditionalData.Elem().Interface()):
+	case v.Elem().IsZero():
+		dstAdditionalData.Set(reflect.Zero(dstAdditionalData.Type()))
+	default:
+		dstAdditionalData.Set(v)
 	}
 	return nil
 }
 
+// isADType reports whether t can hold AdditionalData: null.JSON or types.JSON, or a pointer to either.
+func isADType(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t == reflect.TypeOf(null.JSON{}) || t == reflect.TypeOf(boilertypes.JSON{})
+}
+
+// storeAdditionalData sets a null.JSON or types.JSON AdditionalData field (or a pointer to one, which is
+// newly allocated) to the marshaled bytes b.
+func storeAdditionalData(dstAdditionalData reflect.Value, b []byte) {
+	if dstAdditionalData.Kind() == reflect.Ptr {
+		n := reflect.New(dstAdditionalData.Type().Elem())
+		storeAdditionalData(n.Elem(), b)
+		dstAdditionalData.Set(n)
+		return
+	}
+	switch dstAdditionalData.Type() {
+	case reflect.TypeOf(null.JSON{}):
+		dstAdditionalData.Set(reflect.ValueOf(null.JSONFrom(b)))
+	case reflect.TypeOf(boilertypes.JSON{}):
+		dstAdditionalData.Set(reflect.ValueOf(boilertypes.JSON(b)))
+	}
+}
+
 // --- validators ---
 func (a *Adapter) runValidators(dstField reflect.Value, fieldName string, srcRoot, dstRoot reflect.Type) error {
 	vreg := a.validators.Load().(*validatorRegistry)
@@ -0,0 +1,69 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	boilertypes "github.com/aarondl/sqlboiler/v4/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type thirdPartyRecord struct {
+	Call   string
+	Extras null.JSON
+}
+
+type wideRecord struct {
+	Call string
+	Rig  string
+}
+
+func TestAdditionalDataFieldName_BothDirections(t *testing.T) {
+	a := NewWithOptions(WithAdditionalDataFieldName("Extras"))
+	rec := &thirdPartyRecord{}
+	require.NoError(t, a.Into(rec, &wideRecord{Call: "M0CMC", Rig: "IC-7300"}))
+	assert.Equal(t, "M0CMC", rec.Call)
+	assert.JSONEq(t, `{"Rig":"IC-7300"}`, string(rec.Extras.JSON))
+
+	back := &wideRecord{}
+	require.NoError(t, a.Into(back, rec))
+	assert.Equal(t, wideRecord{Call: "M0CMC", Rig: "IC-7300"}, *back)
+}
+
+func TestAdditionalDataFieldName_NotSetByDefault(t *testing.T) {
+	type dst struct {
+		Call   string
+		Extras string
+	}
+	d := &dst{}
+	require.NoError(t, New().Into(d, &thirdPartyRecord{Call: "M0CMC", Extras: null.JSONFrom([]byte(`{"Rig":"x"}`))}))
+	assert.Equal(t, "M0CMC", d.Call)
+	assert.Empty(t, d.Extras, "without the option Extras is a regular (incompatible) field")
+}
+
+func TestAdditionalDataFieldName_UnsupportedTypeIgnored(t *testing.T) {
+	type rec struct {
+		Call   string
+		Extras string
+	}
+	a := NewWithOptions(WithAdditionalDataFieldName("Extras"))
+	r := &rec{}
+	require.NoError(t, a.Into(r, &wideRecord{Call: "M0CMC", Rig: "IC-7300"}))
+	assert.Empty(t, r.Extras, "a string field is not an AdditionalData sink")
+}
+
+func TestAdditionalDataFieldName_BoilerTypesAndDefaultName(t *testing.T) {
+	type rec struct {
+		Call   string
+		Custom boilertypes.JSON
+	}
+	a := NewWithOptions(WithAdditionalDataFieldName("Custom"))
+	r := &rec{}
+	require.NoError(t, a.Into(r, &wideRecord{Rig: "FT-991"}))
+	assert.JSONEq(t, `{"Rig":"FT-991"}`, string(r.Custom))
+
+	d := &fanoutDst{}
+	require.NoError(t, a.Into(d, &wideRecord{Rig: "FT-991"}))
+	assert.JSONEq(t, `{"Rig":"FT-991"}`, string(d.AdditionalData.JSON), "the AdditionalData name still works")
+}
@@ -0,0 +1,80 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	boilertypes "github.com/aarondl/sqlboiler/v4/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type jsonNullDst struct {
+	Call string
+	Rig  string
+}
+
+func TestADJSONNull_NullJSON(t *testing.T) {
+	type src struct {
+		Call           string
+		AdditionalData null.JSON
+	}
+	for _, raw := range []string{`null`, " null\n"} {
+		d := &jsonNullDst{Rig: "kept"}
+		require.NoError(t, New().Into(d, &src{Call: "M0CMC", AdditionalData: null.JSONFrom([]byte(raw))}), raw)
+		assert.Equal(t, jsonNullDst{Call: "M0CMC", Rig: "kept"}, *d)
+	}
+}
+
+func TestADJSONNull_BoilerTypesJSON(t *testing.T) {
+	type src struct {
+		Call           string
+		AdditionalData boilertypes.JSON
+	}
+	type ptrSrc struct {
+		Call           string
+		AdditionalData *boilertypes.JSON
+	}
+	d := &jsonNullDst{}
+	require.NoError(t, New().Into(d, &src{Call: "M0CMC", AdditionalData: boilertypes.JSON(`null`)}))
+	assert.Equal(t, jsonNullDst{Call: "M0CMC"}, *d)
+
+	raw := boilertypes.JSON(`null`)
+	require.NoError(t, New().Into(d, &ptrSrc{Call: "G4ABC", AdditionalData: &raw}))
+	assert.Equal(t, "G4ABC", d.Call)
+}
+
+func TestADJSONNull_PreprocessorNotCalled(t *testing.T) {
+	type src struct {
+		AdditionalData null.JSON
+	}
+	called := false
+	a := NewWithOptions(WithAdditionalDataPreprocessor(func(raw []byte) ([]byte, error) {
+		called = true
+		return raw, nil
+	}))
+	require.NoError(t, a.Into(&jsonNullDst{}, &src{AdditionalData: null.JSONFrom([]byte(`null`))}))
+	assert.False(t, called, "JSON null is no data, like an invalid null.JSON")
+
+	a = NewWithOptions(WithAdditionalDataPreprocessor(func([]byte) ([]byte, error) { return []byte(`null`), nil }))
+	require.NoError(t, a.Into(&jsonNullDst{}, &src{AdditionalData: null.JSONFrom([]byte(`"null"`))}))
+}
+
+func TestADJSONNull_RoundTripWithEmptyAsJSONNull(t *testing.T) {
+	type model struct {
+		Call           string
+		AdditionalData null.JSON
+	}
+	a := NewWithOptions(WithEmptyAdditionalData(EmptyAsJSONNull))
+	m := &model{}
+	require.NoError(t, a.Into(m, &jsonNullDst{Call: "M0CMC"}))
+	require.Equal(t, `null`, string(m.AdditionalData.JSON))
+
+	back := &jsonNullDst{}
+	require.NoError(t, a.Into(back, m))
+	assert.Equal(t, jsonNullDst{Call: "M0CMC"}, *back)
+
+	merged := &model{AdditionalData: null.JSONFrom([]byte(`null`))}
+	require.NoError(t, NewWithOptions(WithMergeAdditionalData(true)).Into(merged, &jsonNullDst{Rig: "IC-7300"}))
+	assert.JSONEq(t, `{"Rig":"IC-7300"}`, string(merged.AdditionalData.JSON))
+}
@@ -0,0 +1,65 @@
+package adapters
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type admcSrc struct {
+	Name    string
+	Started time.Time
+}
+
+type admcDst struct {
+	Name           string
+	AdditionalData null.JSON
+}
+
+func rfc3339(v interface{}) (interface{}, error) {
+	return v.(time.Time).Format(time.RFC3339), nil
+}
+
+func TestADMarshalConverter_Applied(t *testing.T) {
+	a := New()
+	a.RegisterAdditionalDataMarshalConverter("Started", rfc3339)
+	ts := time.Date(2024, 6, 1, 12, 30, 0, 0, time.UTC)
+	d := admcDst{}
+	require.NoError(t, a.Into(&d, &admcSrc{Name: "n", Started: ts}))
+	var m map[string]any
+	require.NoError(t, json.Unmarshal(d.AdditionalData.JSON, &m))
+	assert.Equal(t, "2024-06-01T12:30:00Z", m["Started"])
+}
+
+func TestADMarshalConverter_NotAppliedToMatchedFields(t *testing.T) {
+	a := New()
+	a.RegisterAdditionalDataMarshalConverter("Name", func(interface{}) (interface{}, error) { return "x", nil })
+	d := admcDst{}
+	require.NoError(t, a.Into(&d, &admcSrc{Name: "n"}))
+	assert.Equal(t, "n", d.Name)
+}
+
+func TestADMarshalConverter_ErrorAborts(t *testing.T) {
+	a := New()
+	a.RegisterAdditionalDataMarshalConverter("Started", func(interface{}) (interface{}, error) { return nil, errors.New("bad time") })
+	err := a.Into(&admcDst{}, &admcSrc{Started: time.Now()})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Started")
+	assert.Contains(t, err.Error(), "bad time")
+}
+
+func TestADMarshalConverter_WinsOverTransform(t *testing.T) {
+	a := New()
+	a.RegisterMarshalTransform("Started", func(interface{}) interface{} { return "transform" })
+	a.RegisterAdditionalDataMarshalConverter("Started", func(interface{}) (interface{}, error) { return "converter", nil })
+	d := admcDst{}
+	require.NoError(t, a.Into(&d, &admcSrc{Started: time.Now()}))
+	var m map[string]any
+	require.NoError(t, json.Unmarshal(d.AdditionalData.JSON, &m))
+	assert.Equal(t, "converter", m["Started"])
+}
@@ -0,0 +1,75 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	boilertypes "github.com/aarondl/sqlboiler/v4/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mergeSrc struct {
+	Call string
+	Rig  string
+	Ant  string
+}
+
+type mergeDst struct {
+	Call           string
+	AdditionalData null.JSON
+}
+
+func preset(js string) *mergeDst {
+	return &mergeDst{AdditionalData: null.JSONFrom([]byte(js))}
+}
+
+func TestMergeAdditionalData_DisjointKeys(t *testing.T) {
+	a := NewWithOptions(WithMergeAdditionalData(true))
+	d := preset(`{"Grid":"IO93","Big":12345678901234567890}`)
+	require.NoError(t, a.Into(d, &mergeSrc{Call: "M0CMC", Rig: "IC-7300"}))
+	assert.JSONEq(t, `{"Grid":"IO93","Big":12345678901234567890,"Rig":"IC-7300"}`, string(d.AdditionalData.JSON))
+	assert.Contains(t, string(d.AdditionalData.JSON), "12345678901234567890", "existing values are kept verbatim")
+}
+
+func TestMergeAdditionalData_OverlappingNewWins(t *testing.T) {
+	a := NewWithOptions(WithMergeAdditionalData(true))
+	d := preset(`{"Rig":"FT-991","Grid":"IO93"}`)
+	require.NoError(t, a.Into(d, &mergeSrc{Rig: "IC-7300", Ant: "dipole"}))
+	assert.JSONEq(t, `{"Rig":"IC-7300","Ant":"dipole","Grid":"IO93"}`, string(d.AdditionalData.JSON))
+}
+
+func TestMergeAdditionalData_OverlappingKeepExisting(t *testing.T) {
+	a := NewWithOptions(WithMergeAdditionalData(true), WithMergeKeepExisting(true))
+	d := preset(`{"Rig":"FT-991","Grid":"IO93"}`)
+	require.NoError(t, a.Into(d, &mergeSrc{Rig: "IC-7300", Ant: "dipole"}))
+	assert.JSONEq(t, `{"Rig":"FT-991","Ant":"dipole","Grid":"IO93"}`, string(d.AdditionalData.JSON))
+}
+
+func TestMergeAdditionalData_NothingNewKeepsExisting(t *testing.T) {
+	a := NewWithOptions(WithMergeAdditionalData(true))
+	d := preset(`{"Grid":"IO93"}`)
+	require.NoError(t, a.Into(d, &mergeSrc{Call: "M0CMC"}))
+	assert.JSONEq(t, `{"Grid":"IO93"}`, string(d.AdditionalData.JSON))
+
+	type BD struct {
+		Call           string
+		AdditionalData boilertypes.JSON
+	}
+	b := &BD{}
+	require.NoError(t, a.Into(b, &mergeSrc{Rig: "IC-7300"}))
+	require.NoError(t, a.Into(b, &mergeSrc{Ant: "yagi"}))
+	assert.JSONEq(t, `{"Rig":"IC-7300","Ant":"yagi"}`, string(b.AdditionalData))
+}
+
+func TestMergeAdditionalData_DefaultReplaces(t *testing.T) {
+	d := preset(`{"Grid":"IO93"}`)
+	require.NoError(t, New().Into(d, &mergeSrc{Rig: "IC-7300"}))
+	assert.JSONEq(t, `{"Rig":"IC-7300"}`, string(d.AdditionalData.JSON))
+}
+
+func TestMergeAdditionalData_InvalidExistingErrors(t *testing.T) {
+	err := NewWithOptions(WithMergeAdditionalData(true)).Into(preset(`[1]`), &mergeSrc{Rig: "x"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "decoding existing AdditionalData")
+}
@@ -0,0 +1,71 @@
+package adapters
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	boilertypes "github.com/aarondl/sqlboiler/v4/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type nonObjSrc struct {
+	Call           string
+	AdditionalData null.JSON
+}
+
+type nonObjDst struct {
+	Call string
+	Rig  string
+}
+
+func TestNonObjectAdditionalData_ErrorsByDefault(t *testing.T) {
+	cases := map[string]string{
+		`[1,2]`:    "got array",
+		` "n/a"`:   "got string",
+		`42`:       "got number",
+		`-1.5`:     "got number",
+		"\ttrue\n": "got boolean",
+	}
+	for js, want := range cases {
+		err := New().Into(&nonObjDst{}, &nonObjSrc{AdditionalData: null.JSONFrom([]byte(js))})
+		require.Error(t, err, js)
+		assert.True(t, errors.Is(err, ErrAdditionalDataNotObject), js)
+		assert.Contains(t, err.Error(), want, js)
+	}
+}
+
+func TestNonObjectAdditionalData_InvalidJSONIsDistinct(t *testing.T) {
+	for _, js := range []string{`{"Rig":`, `[1,`, `nope`} {
+		err := New().Into(&nonObjDst{}, &nonObjSrc{AdditionalData: null.JSONFrom([]byte(js))})
+		require.Error(t, err, js)
+		assert.False(t, errors.Is(err, ErrAdditionalDataNotObject), js)
+		assert.Contains(t, err.Error(), "invalid JSON", js)
+	}
+}
+
+func TestNonObjectAdditionalData_Skip(t *testing.T) {
+	a := NewWithOptions(WithSkipNonObjectAdditionalData(true))
+	d := nonObjDst{Rig: "keep"}
+	require.NoError(t, a.Into(&d, &nonObjSrc{Call: "M0CMC", AdditionalData: null.JSONFrom([]byte(`["IC-7300"]`))}))
+	assert.Equal(t, nonObjDst{Call: "M0CMC", Rig: "keep"}, d)
+
+	type BSrc struct {
+		Call           string
+		AdditionalData boilertypes.JSON
+	}
+	require.NoError(t, a.Into(&d, &BSrc{Call: "G0ABC", AdditionalData: boilertypes.JSON(`"n/a"`)}))
+	assert.Equal(t, "G0ABC", d.Call)
+
+	err := a.Into(&d, &nonObjSrc{AdditionalData: null.JSONFrom([]byte(`[`))})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid JSON")
+}
+
+func TestNonObjectAdditionalData_NullAndObjectUnaffected(t *testing.T) {
+	d := nonObjDst{}
+	require.NoError(t, New().Into(&d, &nonObjSrc{AdditionalData: null.JSONFrom([]byte(`null`))}))
+	require.NoError(t, New().Into(&d, &nonObjSrc{AdditionalData: null.JSONFrom([]byte(`{"Rig":"IC-7300"}`))}))
+	assert.Equal(t, "IC-7300", d.Rig)
+}
@@ -0,0 +1,53 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	boilertypes "github.com/aarondl/sqlboiler/v4/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type omitSrc struct {
+	Call string
+	Rig  string
+}
+
+type omitDst struct {
+	Call   string
+	Extras null.JSON `adapter:"additional,omitempty"`
+}
+
+type omitBoilerDst struct {
+	Call           string
+	AdditionalData boilertypes.JSON `adapter:"additional,omitempty"`
+}
+
+func TestADOmitEmpty_RetainsExistingValue(t *testing.T) {
+	existing := null.JSONFrom([]byte(`{"Rig":"FT-991"}`))
+	d := &omitDst{Extras: existing}
+	require.NoError(t, New().Into(d, &omitSrc{Call: "M0CMC"}))
+	assert.Equal(t, "M0CMC", d.Call)
+	assert.Equal(t, existing, d.Extras)
+
+	b := &omitBoilerDst{AdditionalData: boilertypes.JSON(`{"x":1}`)}
+	require.NoError(t, NewWithOptions(WithEmptyAdditionalData(EmptyAsObject)).Into(b, &omitSrc{Call: "M0CMC"}))
+	assert.JSONEq(t, `{"x":1}`, string(b.AdditionalData), "omitempty wins over the global empty style")
+}
+
+func TestADOmitEmpty_StillWritesRemainingFields(t *testing.T) {
+	d := &omitDst{Extras: null.JSONFrom([]byte(`{"Rig":"FT-991"}`))}
+	require.NoError(t, New().Into(d, &omitSrc{Call: "M0CMC", Rig: "IC-7300"}))
+	assert.JSONEq(t, `{"Rig":"IC-7300"}`, string(d.Extras.JSON))
+}
+
+func TestADOmitEmpty_WithoutTagOverwrites(t *testing.T) {
+	type D struct {
+		Call   string
+		Extras null.JSON `adapter:"additional"`
+	}
+	d := &D{Extras: null.JSONFrom([]byte(`{"Rig":"FT-991"}`))}
+	require.NoError(t, New().Into(d, &omitSrc{Call: "M0CMC"}))
+	assert.False(t, d.Extras.Valid)
+}
@@ -0,0 +1,112 @@
+package adapters
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	boilertypes "github.com/aarondl/sqlboiler/v4/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ptrADModel struct {
+	Call   string
+	Extras *null.JSON `adapter:"additional"`
+}
+
+type ptrADBoiler struct {
+	Call           string
+	AdditionalData *boilertypes.JSON
+}
+
+type ptrADType struct {
+	Call string
+	Rig  string
+	Ant  string
+}
+
+func TestPointerAD_MarshalAllocates(t *testing.T) {
+	m := ptrADModel{}
+	require.NoError(t, New().Into(&m, &ptrADType{Call: "M0CMC", Rig: "IC-7300"}))
+	require.NotNil(t, m.Extras)
+	assert.JSONEq(t, `{"Rig":"IC-7300"}`, string(m.Extras.JSON))
+
+	b := ptrADBoiler{}
+	require.NoError(t, New().Into(&b, &ptrADType{Ant: "dipole"}))
+	require.NotNil(t, b.AdditionalData)
+	assert.JSONEq(t, `{"Ant":"dipole"}`, string(*b.AdditionalData))
+}
+
+func TestPointerAD_MarshalDoesNotMutateSharedValue(t *testing.T) {
+	shared := null.JSONFrom([]byte(`{"Rig":"FT-991"}`))
+	m := ptrADModel{Extras: &shared}
+	require.NoError(t, New().Into(&m, &ptrADType{Rig: "IC-7300"}))
+	assert.JSONEq(t, `{"Rig":"IC-7300"}`, string(m.Extras.JSON))
+	assert.JSONEq(t, `{"Rig":"FT-991"}`, string(shared.JSON))
+}
+
+func TestPointerAD_EmptyResult(t *testing.T) {
+	existing := null.JSONFrom([]byte(`{"Rig":"FT-991"}`))
+	m := ptrADModel{Extras: &existing}
+	require.NoError(t, New().Into(&m, &ptrADType{Call: "M0CMC"}))
+	assert.Nil(t, m.Extras, "EmptyAsNull stores a nil pointer")
+
+	m = ptrADModel{}
+	require.NoError(t, NewWithOptions(WithEmptyAdditionalData(EmptyAsObject)).Into(&m, &ptrADType{}))
+	require.NotNil(t, m.Extras)
+	assert.Equal(t, `{}`, string(m.Extras.JSON))
+
+	type OmitModel struct {
+		Extras *null.JSON `adapter:"additional,omitempty"`
+	}
+	o := OmitModel{Extras: &existing}
+	require.NoError(t, New().Into(&o, &ptrADType{}))
+	assert.Same(t, &existing, o.Extras, "omitempty keeps the existing pointer")
+}
+
+func TestPointerAD_MergeIntoPointer(t *testing.T) {
+	existing := null.JSONFrom([]byte(`{"Grid":"IO93"}`))
+	m := ptrADModel{Extras: &existing}
+	require.NoError(t, NewWithOptions(WithMergeAdditionalData(true)).Into(&m, &ptrADType{Rig: "IC-7300"}))
+	assert.JSONEq(t, `{"Grid":"IO93","Rig":"IC-7300"}`, string(m.Extras.JSON))
+}
+
+func TestPointerAD_Unmarshal(t *testing.T) {
+	js := null.JSONFrom([]byte(`{"Rig":"IC-7300","Ant":"yagi"}`))
+	d := ptrADType{}
+	require.NoError(t, New().Into(&d, &ptrADModel{Call: "M0CMC", Extras: &js}))
+	assert.Equal(t, ptrADType{Call: "M0CMC", Rig: "IC-7300", Ant: "yagi"}, d)
+
+	bj := boilertypes.JSON(`{"Rig":"FT-991"}`)
+	d = ptrADType{}
+	require.NoError(t, New().Into(&d, &ptrADBoiler{AdditionalData: &bj}))
+	assert.Equal(t, "FT-991", d.Rig)
+}
+
+func TestPointerAD_NilSource(t *testing.T) {
+	d := ptrADType{Rig: "keep"}
+	require.NoError(t, New().Into(&d, &ptrADModel{Call: "M0CMC"}))
+	assert.Equal(t, ptrADType{Call: "M0CMC", Rig: "keep"}, d)
+
+	m := ptrADModel{}
+	require.NoError(t, New().Into(&m, &ptrADBoiler{Call: "M0CMC"}))
+	assert.Nil(t, m.Extras)
+}
+
+func TestPointerAD_HelpersAndLint(t *testing.T) {
+	js := null.JSONFrom([]byte(`{"Rig":"IC-7300"}`))
+	got, err := AdditionalDataMap(&js)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"Rig": "IC-7300"}, got)
+	got, err = AdditionalDataMap((*boilertypes.JSON)(nil))
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	assert.Empty(t, CheckStruct(reflect.TypeOf(ptrADModel{})))
+
+	m := ptrADModel{}
+	require.NoError(t, New().FromMap(&m, map[string]string{"Call": "M0CMC", "Rig": "IC-7300"}))
+	require.NotNil(t, m.Extras)
+	assert.JSONEq(t, `{"Rig":"IC-7300"}`, string(m.Extras.JSON))
+}
@@ -0,0 +1,70 @@
+package adapters
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ppSrc struct {
+	Call           string
+	AdditionalData null.JSON
+}
+
+type ppDst struct {
+	Call string
+	Rig  string
+}
+
+func stripBOM(raw []byte) ([]byte, error) {
+	return bytes.TrimPrefix(raw, []byte("\xef\xbb\xbf")), nil
+}
+
+// unwrapString decodes double-encoded JSON: a JSON string whose contents are the JSON object.
+func unwrapString(raw []byte) ([]byte, error) {
+	if len(raw) == 0 || raw[0] != '"' {
+		return raw, nil
+	}
+	var inner string
+	if err := json.Unmarshal(raw, &inner); err != nil {
+		return nil, err
+	}
+	return []byte(inner), nil
+}
+
+func TestADPreprocessor_BOM(t *testing.T) {
+	src := &ppSrc{Call: "M0CMC", AdditionalData: null.JSONFrom([]byte("\xef\xbb\xbf{\"Rig\":\"IC-7300\"}"))}
+	require.Error(t, New().Into(&ppDst{}, src), "a BOM is invalid JSON without preprocessing")
+
+	d := &ppDst{}
+	require.NoError(t, NewWithOptions(WithAdditionalDataPreprocessor(stripBOM)).Into(d, src))
+	assert.Equal(t, ppDst{Call: "M0CMC", Rig: "IC-7300"}, *d)
+}
+
+func TestADPreprocessor_DoubleEncoded(t *testing.T) {
+	src := &ppSrc{AdditionalData: null.JSONFrom([]byte(`"{\"Rig\":\"FT-991\"}"`))}
+	d := &ppDst{}
+	require.NoError(t, NewWithOptions(WithAdditionalDataPreprocessor(unwrapString)).Into(d, src))
+	assert.Equal(t, "FT-991", d.Rig)
+	assert.Equal(t, `"{\"Rig\":\"FT-991\"}"`, string(src.AdditionalData.JSON), "source bytes untouched")
+}
+
+func TestADPreprocessor_ErrorAborts(t *testing.T) {
+	errBad := errors.New("bad extras")
+	a := NewWithOptions(WithAdditionalDataPreprocessor(func([]byte) ([]byte, error) { return nil, errBad }))
+	err := a.Into(&ppDst{}, &ppSrc{AdditionalData: null.JSONFrom([]byte(`{}`))})
+	require.ErrorIs(t, err, errBad)
+	assert.Contains(t, err.Error(), "unmarshaling AdditionalData")
+}
+
+func TestADPreprocessor_EmptyResultSkips(t *testing.T) {
+	a := NewWithOptions(WithAdditionalDataPreprocessor(func([]byte) ([]byte, error) { return nil, nil }))
+	d := &ppDst{Rig: "keep"}
+	require.NoError(t, a.Into(d, &ppSrc{AdditionalData: null.JSONFrom([]byte(`{"Rig":"x"}`))}))
+	assert.Equal(t, "keep", d.Rig)
+}
@@ -0,0 +1,80 @@
+package adapters
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type adSliceDst struct {
+	Call  string
+	Tags  []string
+	Bands []int
+	Meta  map[string]int
+}
+
+func TestADSlice_DirectUnmarshal(t *testing.T) {
+	d := adSliceDst{}
+	require.NoError(t, New().Into(&d, adSrc(`{"Tags":["a","b"],"Bands":[20,40],"Meta":{"n":1}}`)))
+	assert.Equal(t, adSliceDst{Call: "G0ABC", Tags: []string{"a", "b"}, Bands: []int{20, 40}, Meta: map[string]int{"n": 1}}, d)
+}
+
+func TestADSlice_ConverterReceivesTypedSlice(t *testing.T) {
+	var gotTags, gotBands, gotMeta interface{}
+	a := New()
+	a.RegisterConverter("Tags", func(v interface{}) (interface{}, error) {
+		gotTags = v
+		tags := append([]string(nil), v.([]string)...)
+		for i := range tags {
+			tags[i] = strings.ToUpper(tags[i])
+		}
+		return tags, nil
+	})
+	a.RegisterConverter("Bands", func(v interface{}) (interface{}, error) {
+		gotBands = v
+		bands := append([]int(nil), v.([]int)...)
+		sort.Ints(bands)
+		return bands, nil
+	})
+	a.RegisterConverter("Meta", func(v interface{}) (interface{}, error) {
+		gotMeta = v
+		return v, nil
+	})
+	d := adSliceDst{}
+	require.NoError(t, a.Into(&d, adSrc(`{"Tags":["a","b"],"Bands":[40,20],"Meta":{"n":1}}`)))
+	assert.IsType(t, []string(nil), gotTags)
+	assert.IsType(t, []int(nil), gotBands)
+	assert.IsType(t, map[string]int(nil), gotMeta)
+	assert.Equal(t, []string{"A", "B"}, d.Tags)
+	assert.Equal(t, []int{20, 40}, d.Bands)
+	assert.Equal(t, map[string]int{"n": 1}, d.Meta)
+}
+
+func TestADSlice_ConverterSkippedOnTypeMismatch(t *testing.T) {
+	called := false
+	a := New()
+	a.RegisterConverter("Bands", func(v interface{}) (interface{}, error) {
+		called = true
+		return v, nil
+	})
+	d := adSliceDst{Bands: []int{1}}
+	require.NoError(t, a.Into(&d, adSrc(`{"Bands":["twenty"]}`)))
+	assert.False(t, called)
+	assert.Equal(t, []int{1}, d.Bands)
+}
+
+func TestADSlice_ScalarConverterStillGeneric(t *testing.T) {
+	var got interface{}
+	a := New()
+	a.RegisterConverter("Rig", func(v interface{}) (interface{}, error) {
+		got = v
+		return "ic-7300", nil
+	})
+	dst := &struct{ Rig string }{}
+	require.NoError(t, a.Into(dst, adSrc(`{"Rig":7300}`)))
+	assert.Equal(t, float64(7300), got, "non-aggregate fields keep the generic decoding")
+	assert.Equal(t, "ic-7300", dst.Rig)
+}
@@ -0,0 +1,122 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type classifySrc struct {
+	Call           string
+	Freq           string
+	AdditionalData null.JSON
+}
+
+type classifyDst struct {
+	Call string
+	Freq int64
+}
+
+func asAdaptError(t *testing.T, err error) *AdaptError {
+	t.Helper()
+	var ae *AdaptError
+	require.True(t, errors.As(err, &ae), "expected an AdaptError, got %T: %v", err, err)
+	return ae
+}
+
+func TestAdaptError_ConverterErrorIsRecoverable(t *testing.T) {
+	a := New()
+	a.RegisterConverter("Freq", func(v interface{}) (interface{}, error) { return strconv.ParseInt(v.(string), 10, 64) })
+	err := a.Into(&classifyDst{}, &classifySrc{Freq: "not-a-freq"})
+	ae := asAdaptError(t, err)
+	assert.True(t, ae.Recoverable())
+	assert.Equal(t, "Freq", ae.Field)
+	assert.Contains(t, err.Error(), `adapting field Freq: converter for Freq failed on "not-a-freq" (string)`, "the message is unchanged")
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+}
+
+func TestAdaptError_WrongReturnTypeIsFatal(t *testing.T) {
+	a := New()
+	a.RegisterConverter("Freq", func(v interface{}) (interface{}, error) { return v, nil }) // string, not int64
+	ae := asAdaptError(t, a.Into(&classifyDst{}, &classifySrc{Freq: "14320000"}))
+	assert.False(t, ae.Recoverable())
+	assert.Equal(t, "Freq", ae.Field)
+}
+
+func TestAdaptError_ValidatorIsRecoverable(t *testing.T) {
+	a := New()
+	errEmpty := errors.New("empty call")
+	a.RegisterValidator("Call", func(v interface{}) error {
+		if v.(string) == "" {
+			return errEmpty
+		}
+		return nil
+	})
+	err := a.Into(&classifyDst{}, &classifySrc{})
+	assert.True(t, asAdaptError(t, err).Recoverable())
+	assert.Equal(t, errEmpty.Error(), err.Error())
+	assert.ErrorIs(t, err, errEmpty)
+}
+
+func TestAdaptError_InvalidAdditionalDataIsRecoverable(t *testing.T) {
+	type dst struct{ Call string }
+	err := New().Into(&dst{}, &classifySrc{AdditionalData: null.JSONFrom([]byte(`[1,2]`))})
+	ae := asAdaptError(t, err)
+	assert.True(t, ae.Recoverable())
+	assert.Empty(t, ae.Field)
+	assert.ErrorIs(t, err, ErrAdditionalDataNotObject)
+}
+
+func TestAdaptError_StrictIncompatibleIsFatal(t *testing.T) {
+	type src struct{ Freq []int }
+	type dst struct{ Freq string }
+	assert.False(t, asAdaptError(t, NewStrict().Into(&dst{}, &src{Freq: []int{1}})).Recoverable())
+
+	type extra struct{ Call, Rig string }
+	type narrow struct{ Call string }
+	assert.False(t, asAdaptError(t, NewStrict().Into(&narrow{}, &extra{})).Recoverable(), "unmapped source fields")
+}
+
+func TestAdaptError_NestedKeepsCategory(t *testing.T) {
+	a := NewWithOptions(WithNestedStructs(true))
+	a.RegisterConverterFor(classifyDst{}, "Freq", func(v interface{}) (interface{}, error) { return v, nil })
+	type src struct{ Details []classifySrc }
+	type dst struct{ Details []classifyDst }
+	err := a.Into(&dst{}, &src{Details: []classifySrc{{Freq: "1"}}})
+	ae := asAdaptError(t, err)
+	assert.Equal(t, "Details", ae.Field, "the outermost field is reported")
+	assert.False(t, ae.Recoverable(), "a fatal element error stays fatal")
+}
+
+func TestAdaptError_MalformedNestedTypeIsFatal(t *testing.T) {
+	bad := dupJSONType("call")
+	outer := reflect.StructOf([]reflect.StructField{{Name: "Inner", Type: reflect.PointerTo(bad)}})
+	type inner struct{ Call string }
+	type src struct{ Inner *inner }
+	err := NewWithOptions(WithNestedStructs(true)).Into(reflect.New(outer).Interface(), &src{Inner: &inner{Call: "x"}})
+	assert.False(t, asAdaptError(t, err).Recoverable())
+}
+
+func TestAdaptError_ContextErrorsUnwrapped(t *testing.T) {
+	a := New()
+	a.RegisterContextConverter("Call", func(ctx context.Context, v interface{}) (interface{}, error) { return v, nil })
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := a.IntoCtx(ctx, &classifyDst{}, &classifySrc{Call: "x"})
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestAdaptError_BulkWrapping(t *testing.T) {
+	a := New()
+	a.RegisterConverter("Freq", func(v interface{}) (interface{}, error) { return strconv.ParseInt(v.(string), 10, 64) })
+	var out []classifyDst
+	err := a.AdaptSliceCtx(context.Background(), &out, []classifySrc{{Freq: "1"}, {Freq: "x"}})
+	require.Error(t, err)
+	assert.True(t, asAdaptError(t, err).Recoverable())
+}
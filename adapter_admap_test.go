@@ -0,0 +1,62 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	boilertypes "github.com/aarondl/sqlboiler/v4/types"
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdditionalDataMap(t *testing.T) {
+	want := map[string]interface{}{"Rig": "IC-7300", "Power": float64(100)}
+	js := []byte(`{"Rig":"IC-7300","Power":100}`)
+	tests := []struct {
+		name    string
+		input   interface{}
+		want    map[string]interface{}
+		wantErr bool
+	}{
+		{name: "null.JSON", input: null.JSONFrom(js), want: want},
+		{name: "invalid null.JSON", input: null.JSON{}, want: nil},
+		{name: "types.JSON", input: boilertypes.JSON(js), want: want},
+		{name: "empty types.JSON", input: boilertypes.JSON(nil), want: nil},
+		{name: "json.RawMessage", input: json.RawMessage(js), want: want},
+		{name: "[]byte", input: js, want: want},
+		{name: "JSON null", input: []byte("null"), want: nil},
+		{name: "not an object", input: []byte(`[1,2]`), wantErr: true},
+		{name: "invalid JSON", input: []byte(`{`), wantErr: true},
+		{name: "string", input: string(js), wantErr: true},
+		{name: "nil", input: nil, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AdditionalDataMap(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAdditionalDataMap_AfterInto(t *testing.T) {
+	type S struct {
+		Call string
+		Rig  string
+	}
+	type D struct {
+		Call           string
+		AdditionalData null.JSON
+	}
+	d := &D{}
+	require.NoError(t, New().Into(d, &S{Call: "M0CMC", Rig: "IC-7300"}))
+	m, err := AdditionalDataMap(d.AdditionalData)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"Rig": "IC-7300"}, m)
+}
@@ -0,0 +1,91 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type apSrc struct {
+	Call           string
+	AdditionalData null.JSON
+}
+
+type apDst struct {
+	Call    string
+	QslSent string `adapter:"adpath=qsl.sent"`
+	QslVia  string `adapter:"adpath=qsl.via.call"`
+	Power   int    `adapter:"adpath=rig.power"`
+}
+
+func adSrc(js string) *apSrc {
+	return &apSrc{Call: "G0ABC", AdditionalData: null.JSONFrom([]byte(js))}
+}
+
+func TestADPath_Present(t *testing.T) {
+	a := New()
+	d := apDst{}
+	require.NoError(t, a.Into(&d, adSrc(`{"qsl":{"sent":"Y","via":{"call":"M0XYZ"}},"rig":{"power":100}}`)))
+	assert.Equal(t, apDst{Call: "G0ABC", QslSent: "Y", QslVia: "M0XYZ", Power: 100}, d)
+}
+
+func TestADPath_AbsentLeavesFieldUnset(t *testing.T) {
+	a := New()
+	cases := []string{
+		`{}`,
+		`{"qsl":{}}`,
+		`{"qsl":null}`,
+		`{"qsl":"Y"}`,
+		`{"qsl":{"via":"direct"}}`,
+	}
+	for _, js := range cases {
+		d := apDst{QslSent: "keep", QslVia: "keep"}
+		require.NoError(t, a.Into(&d, adSrc(js)), js)
+		assert.Equal(t, "keep", d.QslSent, js)
+		assert.Equal(t, "keep", d.QslVia, js)
+	}
+}
+
+func TestADPath_TopLevelKeyNotUsed(t *testing.T) {
+	a := New()
+	d := apDst{}
+	require.NoError(t, a.Into(&d, adSrc(`{"QslSent":"N","qsl":{"sent":"Y"}}`)))
+	assert.Equal(t, "Y", d.QslSent)
+
+	d = apDst{}
+	require.NoError(t, a.Into(&d, adSrc(`{"QslSent":"N"}`)))
+	assert.Equal(t, "", d.QslSent)
+}
+
+func TestADPath_CaseInsensitive(t *testing.T) {
+	a := NewWithOptions(WithCaseInsensitiveAdditionalData(true))
+	d := apDst{}
+	require.NoError(t, a.Into(&d, adSrc(`{"QSL":{"Sent":"Y"}}`)))
+	assert.Equal(t, "Y", d.QslSent)
+}
+
+func TestADPath_ConverterApplies(t *testing.T) {
+	a := New()
+	a.RegisterConverter("QslSent", func(v interface{}) (interface{}, error) {
+		if v.(string) == "Y" {
+			return "yes", nil
+		}
+		return "no", nil
+	})
+	d := apDst{}
+	require.NoError(t, a.Into(&d, adSrc(`{"qsl":{"sent":"Y"}}`)))
+	assert.Equal(t, "yes", d.QslSent)
+}
+
+func TestADPath_PreferFieldsRespected(t *testing.T) {
+	a := New()
+	type S struct {
+		QslSent        string
+		AdditionalData null.JSON
+	}
+	d := apDst{}
+	require.NoError(t, a.Into(&d, &S{QslSent: "direct", AdditionalData: null.JSONFrom([]byte(`{"qsl":{"sent":"Y"}}`))}))
+	assert.Equal(t, "direct", d.QslSent)
+}
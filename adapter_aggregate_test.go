@@ -0,0 +1,79 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArray_ElementWiseConvert(t *testing.T) {
+	a := New()
+	type S struct{ Flags [4]byte }
+	type D struct{ Flags [4]int }
+	s := S{Flags: [4]byte{1, 2, 3, 4}}
+	d := D{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, [4]int{1, 2, 3, 4}, d.Flags)
+}
+
+func TestArray_ToSlice(t *testing.T) {
+	a := New()
+	type S struct{ Flags [3]byte }
+	type D struct{ Flags []byte }
+	s := S{Flags: [3]byte{7, 8, 9}}
+	d := D{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, []byte{7, 8, 9}, d.Flags)
+}
+
+func TestSlice_ToArray(t *testing.T) {
+	a := New()
+	type S struct{ Flags []int32 }
+	type D struct{ Flags [2]int64 }
+	s := S{Flags: []int32{5, 6}}
+	d := D{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, [2]int64{5, 6}, d.Flags)
+
+	s2 := S{}
+	d2 := D{Flags: [2]int64{1, 1}}
+	require.NoError(t, a.Into(&d2, &s2))
+	assert.Equal(t, [2]int64{}, d2.Flags)
+}
+
+func TestArray_LengthMismatch(t *testing.T) {
+	a := New()
+	type S struct{ Flags []byte }
+	type D struct{ Flags [4]byte }
+	s := S{Flags: []byte{1, 2}}
+	d := D{}
+	err := a.Into(&d, &s)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Flags")
+	assert.Contains(t, err.Error(), "length mismatch")
+
+	type S2 struct{ Flags [3]byte }
+	s2 := S2{}
+	assert.Error(t, a.Into(&d, &s2))
+}
+
+func TestArray_IncompatibleElementsSkipped(t *testing.T) {
+	a := New()
+	type S struct{ Flags [2]struct{ X int } }
+	type D struct{ Flags [2]string }
+	s := S{}
+	d := D{Flags: [2]string{"a", "b"}}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, [2]string{"a", "b"}, d.Flags)
+}
+
+func TestArray_IntToStringElementsRejected(t *testing.T) {
+	a := New()
+	type S struct{ Codes [2]int }
+	type D struct{ Codes []string }
+	d := D{Codes: []string{"keep"}}
+	require.NoError(t, a.Into(&d, &S{Codes: [2]int{65, 66}}))
+	assert.Equal(t, []string{"keep"}, d.Codes, "integers must not become the strings of their code points")
+	assert.Equal(t, []string{"Codes"}, a.CheckMapping(S{}, D{}).Incompatible)
+}
@@ -0,0 +1,68 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type allocCallsign string
+
+type allocSrc struct {
+	Call  string
+	Freq  int
+	Power float32
+	Band  allocCallsign
+	QSL   bool
+	Count uint8
+}
+
+type allocDst struct {
+	Call  string
+	Freq  int64
+	Power float64
+	Band  string
+	QSL   bool
+	Count uint32
+}
+
+func TestAllocs_BasicCopyIsAllocationFree(t *testing.T) {
+	a := New()
+	src := &allocSrc{Call: "M0CMC", Freq: 14074000, Power: 100, Band: "20m", QSL: true, Count: 3}
+	dst := &allocDst{}
+	require.NoError(t, a.Into(dst, src)) // build metadata and plan
+	assert.Equal(t, allocDst{Call: "M0CMC", Freq: 14074000, Power: 100, Band: "20m", QSL: true, Count: 3}, *dst)
+
+	assert.Zero(t, testing.AllocsPerRun(100, func() { _ = a.Into(dst, src) }))
+
+	compiled, err := a.Compile(src, dst)
+	require.NoError(t, err)
+	assert.Zero(t, testing.AllocsPerRun(100, func() { _ = compiled(dst, src) }))
+}
+
+func TestAllocs_ScratchDoesNotLeakBetweenCalls(t *testing.T) {
+	type S struct {
+		Call           string
+		Rig            string
+		AdditionalData null.JSON
+	}
+	type D struct {
+		Call           string
+		AdditionalData null.JSON
+	}
+	a := New()
+	d := &D{}
+	require.NoError(t, a.Into(d, &S{Call: "A", Rig: "IC-7300"}))
+	assert.JSONEq(t, `{"Rig":"IC-7300"}`, string(d.AdditionalData.JSON))
+
+	d = &D{}
+	require.NoError(t, a.Into(d, &S{Call: "B"}))
+	assert.False(t, d.AdditionalData.Valid, "fields from the previous call must not reappear")
+
+	back := &S{}
+	require.NoError(t, a.Into(back, &D{AdditionalData: null.JSONFrom([]byte(`{"Rig":"FT-991"}`))}))
+	require.NoError(t, a.Into(back, &D{AdditionalData: null.JSONFrom([]byte(`{}`))}))
+	assert.Equal(t, "FT-991", back.Rig)
+}
@@ -0,0 +1,67 @@
+package adapters
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type anonSrc struct {
+	Name           string
+	Age            int
+	Rig            string
+	AdditionalData null.JSON
+}
+
+func TestMake_AnonymousStruct(t *testing.T) {
+	a := New()
+	got, err := Make[struct {
+		Name string
+		Age  int
+	}](a, &anonSrc{Name: "Marc", Age: 42, Rig: "IC-7300"})
+	require.NoError(t, err)
+	assert.Equal(t, "Marc", got.Name)
+	assert.Equal(t, 42, got.Age)
+
+	var dst struct {
+		Name           string
+		AdditionalData null.JSON
+	}
+	require.NoError(t, Copy(a, &dst, &anonSrc{Name: "Marc", Age: 42}))
+	assert.JSONEq(t, `{"Age":42}`, string(dst.AdditionalData.JSON))
+}
+
+func TestMake_AnonymousStructTypeScopedConverter(t *testing.T) {
+	type view = struct {
+		Name string
+		Age  int
+	}
+	a := New()
+	a.RegisterConverterFor(struct {
+		Name string
+		Age  int
+	}{}, "Name", func(v interface{}) (interface{}, error) { return strings.ToUpper(v.(string)), nil })
+	got, err := Make[view](a, &anonSrc{Name: "marc"})
+	require.NoError(t, err)
+	assert.Equal(t, "MARC", got.Name, "identical anonymous struct types are the same reflect.Type")
+
+	other, err := Make[struct {
+		Name string `json:"name"`
+		Age  int
+	}](a, &anonSrc{Name: "marc"})
+	require.NoError(t, err)
+	assert.Equal(t, "marc", other.Name, "a different tag makes a different type")
+}
+
+func TestAdaptTo_AnonymousSource(t *testing.T) {
+	src := struct {
+		Name string
+		Rig  string
+	}{Name: "Marc", Rig: "FT-991"}
+	got, err := AdaptTo[anonSrc](New(), &src)
+	require.NoError(t, err)
+	assert.Equal(t, anonSrc{Name: "Marc", Rig: "FT-991"}, *got)
+}
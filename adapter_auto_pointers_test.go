@@ -0,0 +1,89 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ptrDTO struct {
+	Call  *string
+	Name  *string
+	Age   *int
+	Power *float64
+	QSL   *bool
+}
+
+type ptrModel struct {
+	Call  string
+	Name  string
+	Age   int64
+	Power float64
+	QSL   bool
+}
+
+func ptrTo[T any](v T) *T { return &v }
+
+func TestAutoPointers_DTOToModel(t *testing.T) {
+	a := NewWithOptions(WithAutoPointers(true))
+	m := &ptrModel{Name: "stale"}
+	require.NoError(t, a.Into(m, &ptrDTO{Call: ptrTo("M0CMC"), Age: ptrTo(42), QSL: ptrTo(true)}))
+	assert.Equal(t, ptrModel{Call: "M0CMC", Age: 42, QSL: true}, *m, "nil pointers set the zero value")
+
+	m = &ptrModel{}
+	require.NoError(t, New().Into(m, &ptrDTO{Call: ptrTo("M0CMC")}))
+	assert.Empty(t, m.Call, "off by default")
+}
+
+func TestAutoPointers_ModelToDTO(t *testing.T) {
+	a := NewWithOptions(WithAutoPointers(true))
+	src := &ptrModel{Call: "M0CMC", Age: 42}
+	d := &ptrDTO{}
+	require.NoError(t, a.Into(d, src))
+	require.NotNil(t, d.Call)
+	assert.Equal(t, "M0CMC", *d.Call)
+	assert.Equal(t, 42, *d.Age)
+	require.NotNil(t, d.Name, "a value source always yields a pointer")
+	assert.Equal(t, "", *d.Name)
+
+	*d.Call = "changed"
+	assert.Equal(t, "M0CMC", src.Call, "the destination does not alias the source")
+}
+
+func TestAutoPointers_RoundTrip(t *testing.T) {
+	a := NewWithOptions(WithAutoPointers(true))
+	dto := &ptrDTO{Call: ptrTo("M0CMC"), Name: ptrTo("Marc"), Age: ptrTo(42), Power: ptrTo(100.0), QSL: ptrTo(false)}
+	m := &ptrModel{}
+	require.NoError(t, a.Into(m, dto))
+	back := &ptrDTO{}
+	require.NoError(t, a.Into(back, m))
+	assert.Equal(t, dto, back)
+}
+
+func TestAutoPointers_PointerToPointer(t *testing.T) {
+	type src struct{ Age *int }
+	type dst struct{ Age *int64 }
+	a := NewWithOptions(WithAutoPointers(true))
+	d := &dst{}
+	require.NoError(t, a.Into(d, &src{Age: ptrTo(7)}))
+	assert.Equal(t, int64(7), *d.Age)
+	require.NoError(t, a.Into(d, &src{}))
+	assert.Nil(t, d.Age)
+	assert.True(t, a.CheckMapping(src{}, dst{}).Clean())
+}
+
+func TestAutoPointers_AdditionalDataNilIsAbsent(t *testing.T) {
+	type model struct {
+		Call           string
+		AdditionalData null.JSON
+	}
+	for _, includeZero := range []bool{false, true} {
+		a := NewWithOptions(WithIncludeZeroValues(includeZero))
+		m := &model{}
+		require.NoError(t, a.Into(m, &ptrDTO{Call: ptrTo("M0CMC"), Name: ptrTo(""), Age: ptrTo(0)}))
+		assert.JSONEq(t, `{"Name":"","Age":0}`, string(m.AdditionalData.JSON),
+			"includeZero=%t: nil pointers are absent, pointers to zero values are kept", includeZero)
+	}
+}
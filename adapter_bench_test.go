@@ -1,8 +1,10 @@
 package adapters
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"github.com/goccy/go-json"
+	"reflect"
 	"testing"
 
 	"github.com/aarondl/null/v8"
@@ -89,6 +91,19 @@ func BenchmarkAdapter_BasicFieldCopy(b *testing.B) {
 	}
 }
 
+func BenchmarkAdapter_BasicFieldCopyNoCache(b *testing.B) {
+	adapter := NewWithOptions(WithoutMetadataCache())
+	src := &BenchSource{ID: 1, Name: "John Doe", Email: "john@example.com", Age: 30, Active: true, Score: 95.5}
+
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		dst := &BenchDest{}
+		_ = adapter.Into(dst, src)
+	}
+}
+
 func BenchmarkAdapter_WithConverter(b *testing.B) {
 	adapter := New()
 
@@ -326,46 +341,146 @@ func BenchmarkAdapter_SmallStruct(b *testing.B) {
 	}
 }
 
+type largeBenchSource struct {
+	F1, F2, F3, F4, F5      string
+	F6, F7, F8, F9, F10     string
+	F11, F12, F13, F14, F15 int
+	F16, F17, F18, F19, F20 int
+	F21, F22, F23, F24, F25 float64
+	F26, F27, F28, F29, F30 float64
+	F31, F32, F33, F34, F35 bool
+	F36, F37, F38, F39, F40 bool
+	F41, F42, F43, F44, F45 string
+	F46, F47, F48, F49, F50 string
+}
+
+type largeBenchDest struct {
+	F1, F2, F3, F4, F5      string
+	F6, F7, F8, F9, F10     string
+	F11, F12, F13, F14, F15 int
+	F16, F17, F18, F19, F20 int
+	F21, F22, F23, F24, F25 float64
+	F26, F27, F28, F29, F30 float64
+	F31, F32, F33, F34, F35 bool
+	F36, F37, F38, F39, F40 bool
+	F41, F42, F43, F44, F45 string
+	F46, F47, F48, F49, F50 string
+}
+
+func benchLargeStruct(b *testing.B, adapter *Adapter) {
+	src := &largeBenchSource{
+		F1: "a", F2: "b", F3: "c", F4: "d", F5: "e",
+		F11: 1, F12: 2, F13: 3, F14: 4, F15: 5,
+		F21: 1.1, F22: 2.2, F23: 3.3, F24: 4.4, F25: 5.5,
+	}
+
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		dst := &largeBenchDest{}
+		_ = adapter.Into(dst, src)
+	}
+}
+
+// slowConverter stands in for a CPU-heavy converter (~several µs) on each string field.
+func slowConverter(v interface{}) (interface{}, error) {
+	sum := sha256.Sum256([]byte(v.(string)))
+	for i := 0; i < 20; i++ {
+		sum = sha256.Sum256(sum[:])
+	}
+	return v, nil
+}
+
+func withSlowStringConverters(a *Adapter) *Adapter {
+	for _, f := range []string{"F1", "F2", "F3", "F4", "F5", "F6", "F7", "F8", "F9", "F10", "F41", "F42", "F43", "F44", "F45", "F46", "F47", "F48", "F49", "F50"} {
+		a.RegisterConverter(f, slowConverter)
+	}
+	return a
+}
+
 func BenchmarkAdapter_LargeStruct(b *testing.B) {
+	benchLargeStruct(b, New())
+}
+
+func BenchmarkAdapter_LargeStructParallel(b *testing.B) {
+	benchLargeStruct(b, NewWithOptions(WithParallelThreshold(16)))
+}
+
+func BenchmarkAdapter_LargeStructSlowConverters(b *testing.B) {
+	benchLargeStruct(b, withSlowStringConverters(New()))
+}
+
+func BenchmarkAdapter_LargeStructSlowConvertersParallel(b *testing.B) {
+	benchLargeStruct(b, withSlowStringConverters(NewWithOptions(WithParallelThreshold(16))))
+}
+
+func BenchmarkAdapter_Compiled(b *testing.B) {
 	adapter := New()
 
-	type LargeSource struct {
-		F1, F2, F3, F4, F5      string
-		F6, F7, F8, F9, F10     string
-		F11, F12, F13, F14, F15 int
-		F16, F17, F18, F19, F20 int
-		F21, F22, F23, F24, F25 float64
-		F26, F27, F28, F29, F30 float64
-		F31, F32, F33, F34, F35 bool
-		F36, F37, F38, F39, F40 bool
-		F41, F42, F43, F44, F45 string
-		F46, F47, F48, F49, F50 string
+	type SmallSource struct {
+		ID   int
+		Name string
 	}
 
-	type LargeDest struct {
-		F1, F2, F3, F4, F5      string
-		F6, F7, F8, F9, F10     string
-		F11, F12, F13, F14, F15 int
-		F16, F17, F18, F19, F20 int
-		F21, F22, F23, F24, F25 float64
-		F26, F27, F28, F29, F30 float64
-		F31, F32, F33, F34, F35 bool
-		F36, F37, F38, F39, F40 bool
-		F41, F42, F43, F44, F45 string
-		F46, F47, F48, F49, F50 string
+	type SmallDest struct {
+		ID   int
+		Name string
 	}
 
-	src := &LargeSource{
-		F1: "a", F2: "b", F3: "c", F4: "d", F5: "e",
-		F11: 1, F12: 2, F13: 3, F14: 4, F15: 5,
-		F21: 1.1, F22: 2.2, F23: 3.3, F24: 4.4, F25: 5.5,
+	fn, err := adapter.Compile(SmallSource{}, SmallDest{})
+	if err != nil {
+		b.Fatal(err)
 	}
+	src := &SmallSource{ID: 1, Name: "Test"}
 
 	b.ResetTimer()
 	b.ReportAllocs()
 
 	for i := 0; i < b.N; i++ {
-		dst := &LargeDest{}
+		dst := &SmallDest{}
+		_ = fn(dst, src)
+	}
+}
+
+type convHeavySource struct {
+	F1, F2, F3, F4, F5, F6 float64
+}
+
+type convHeavyDest struct {
+	F1, F2, F3, F4, F5, F6 float64
+}
+
+var convHeavyFields = []string{"F1", "F2", "F3", "F4", "F5", "F6"}
+
+func benchConvHeavy(b *testing.B, adapter *Adapter) {
+	src := &convHeavySource{F1: 1.5, F2: 2.5, F3: 3.5, F4: 4.5, F5: 5.5, F6: 6.5}
+	dst := &convHeavyDest{}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
 		_ = adapter.Into(dst, src)
 	}
 }
+
+// BenchmarkAdapter_ConverterHeavy and BenchmarkAdapter_ConverterHeavyValue run the same six scaling
+// converters through the interface{} and the reflect.Value signatures.
+func BenchmarkAdapter_ConverterHeavy(b *testing.B) {
+	adapter := New()
+	for _, f := range convHeavyFields {
+		adapter.RegisterConverter(f, func(src interface{}) (interface{}, error) { return src.(float64) * 1000, nil })
+	}
+	benchConvHeavy(b, adapter)
+}
+
+func BenchmarkAdapter_ConverterHeavyValue(b *testing.B) {
+	adapter := New()
+	for _, f := range convHeavyFields {
+		adapter.RegisterConverterValue(f, func(src reflect.Value) (reflect.Value, error) {
+			out := reflect.New(src.Type()).Elem()
+			out.SetFloat(src.Float() * 1000)
+			return out, nil
+		})
+	}
+	benchConvHeavy(b, adapter)
+}
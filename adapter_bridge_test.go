@@ -0,0 +1,104 @@
+package adapters
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// brModel mimics a third-party type keeping state in an unexported field.
+type brModel struct {
+	Call  string
+	state string
+}
+
+func (m *brModel) State() string     { return m.state }
+func (m *brModel) SetState(s string) { m.state = s }
+
+type brType struct {
+	Call  string
+	State string
+}
+
+func registerBrBridge(t *testing.T, a *Adapter) {
+	t.Helper()
+	require.NoError(t, a.RegisterUnexportedBridge(brModel{}, "state",
+		func(p interface{}) interface{} { return p.(*brModel).State() },
+		func(p interface{}, v interface{}) { p.(*brModel).SetState(v.(string)) },
+	))
+}
+
+func TestBridge_WriteUnexported(t *testing.T) {
+	a := New()
+	registerBrBridge(t, a)
+	d := brModel{}
+	require.NoError(t, a.Into(&d, &brType{Call: "G0ABC", State: "confirmed"}))
+	assert.Equal(t, "G0ABC", d.Call)
+	assert.Equal(t, "confirmed", d.state)
+}
+
+func TestBridge_ReadUnexported(t *testing.T) {
+	a := New()
+	registerBrBridge(t, a)
+	d := brType{}
+	// non-addressable source: the getter works on a copy
+	require.NoError(t, a.IntoValue(reflect.ValueOf(&d), reflect.ValueOf(brModel{Call: "G0ABC", state: "pending"})))
+	assert.Equal(t, brType{Call: "G0ABC", State: "pending"}, d)
+}
+
+func TestBridge_BothSides(t *testing.T) {
+	a := New()
+	registerBrBridge(t, a)
+	d := brModel{}
+	require.NoError(t, a.Into(&d, &brModel{Call: "x", state: "s"}))
+	assert.Equal(t, "s", d.state)
+}
+
+func TestBridge_ConverterAndValidator(t *testing.T) {
+	a := New()
+	registerBrBridge(t, a)
+	a.RegisterConverterFor(brModel{}, "state", func(v interface{}) (interface{}, error) {
+		return strings.ToUpper(v.(string)), nil
+	})
+	a.RegisterValidatorFor(brModel{}, "state", func(v interface{}) error {
+		if v.(string) == "" {
+			return errors.New("empty state")
+		}
+		return nil
+	})
+	d := brModel{}
+	require.NoError(t, a.Into(&d, &brType{State: "ok"}))
+	assert.Equal(t, "OK", d.state)
+	assert.Error(t, a.Into(&d, &brType{}))
+}
+
+func TestBridge_WithoutBridgeUnexportedIgnored(t *testing.T) {
+	a := New()
+	d := brModel{state: "keep"}
+	require.NoError(t, a.Into(&d, &brType{Call: "x", State: "new"}))
+	assert.Equal(t, "keep", d.state)
+}
+
+func TestBridge_RegistrationErrors(t *testing.T) {
+	a := New()
+	get := func(interface{}) interface{} { return nil }
+	assert.Error(t, a.RegisterUnexportedBridge(nil, "state", get, nil))
+	assert.Error(t, a.RegisterUnexportedBridge(1, "state", get, nil))
+	assert.Error(t, a.RegisterUnexportedBridge(brModel{}, "missing", get, nil))
+	assert.Error(t, a.RegisterUnexportedBridge(brModel{}, "Call", get, nil))
+	assert.Error(t, a.RegisterUnexportedBridge(brModel{}, "state", nil, nil))
+	assert.NoError(t, a.RegisterUnexportedBridge(&brModel{}, "state", get, nil))
+}
+
+func TestBridge_CheckMapping(t *testing.T) {
+	a := New()
+	registerBrBridge(t, a)
+	r := a.CheckMapping(brType{}, brModel{})
+	require.NoError(t, r.Err)
+	assert.ElementsMatch(t, []string{"Call", "state"}, r.Matched)
+	assert.Empty(t, r.SourceOnly)
+}
@@ -0,0 +1,67 @@
+package adapters
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildError_CachedAndReturnedByEveryInto(t *testing.T) {
+	var misses int
+	a := NewWithOptions(WithCacheObserver(func(e CacheEvent) {
+		if e.Cache == MetadataCache && !e.Hit {
+			misses++
+		}
+	}))
+	type S struct{ Call string }
+	bad := dupJSONType("call")
+
+	first := a.Into(reflect.New(bad).Interface(), &S{Call: "a"})
+	require.Error(t, first)
+	for i := 0; i < 3; i++ {
+		err := a.Into(reflect.New(bad).Interface(), &S{Call: "b"})
+		assert.Same(t, first, err)
+	}
+	// the malformed type is only inspected once
+	assert.Equal(t, 2, misses)
+}
+
+func TestBuildError_SurfacedByOtherEntryPoints(t *testing.T) {
+	a := New()
+	type S struct{ Call string }
+	bad := dupJSONType("call")
+	want := a.Into(reflect.New(bad).Interface(), &S{})
+	require.Error(t, want)
+
+	_, err := a.Compile(S{}, reflect.New(bad).Interface())
+	assert.True(t, errors.Is(err, want))
+	assert.True(t, errors.Is(a.CheckMapping(S{}, reflect.New(bad).Interface()).Err, want))
+	_, err = a.ToMap(reflect.New(bad).Interface())
+	assert.True(t, errors.Is(err, want))
+}
+
+func TestMetadataErr_FirstWins(t *testing.T) {
+	e1, e2 := errors.New("one"), errors.New("two")
+	m := &structMetadata{}
+	m.fail(e1)
+	m.fail(e2)
+	assert.Same(t, e1, m.buildErr)
+	assert.Nil(t, metadataErr(&structMetadata{}))
+	assert.Same(t, e1, metadataErr(&structMetadata{}, m))
+}
+
+func TestBuildError_PanicOnBuildError(t *testing.T) {
+	a := NewWithOptions(WithPanicOnBuildError(true))
+	bad := dupJSONType("call")
+	type S struct{ Call string }
+
+	assert.PanicsWithError(t, New().Into(reflect.New(bad).Interface(), &S{}).Error(), func() {
+		a.WarmMetadata(reflect.New(bad).Interface())
+	})
+	// the cached metadata panics again on later use
+	assert.Panics(t, func() { _ = a.Into(reflect.New(bad).Interface(), &S{}) })
+	assert.NotPanics(t, func() { require.NoError(t, a.Into(&S{}, &S{Call: "ok"})) })
+}
@@ -0,0 +1,58 @@
+package adapters
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type checkedDst struct {
+	Call string
+	Freq int64
+	embeddedDetails
+}
+
+type embeddedDetails struct {
+	Band string
+}
+
+func TestBuilder_BuildChecked_OK(t *testing.T) {
+	a, err := NewBuilder().
+		AddConverter("Anything", MapString(func(s string) string { return s })).
+		AddConverterFor(checkedDst{}, "Call", MapString(func(s string) string { return s })).
+		AddConverterForPair(bSrc{}, &checkedDst{}, "Band", MapString(func(s string) string { return s })).
+		AddValidatorFor(checkedDst{}, "Freq", func(any) error { return nil }).
+		BuildChecked()
+	require.NoError(t, err)
+	require.NotNil(t, a)
+}
+
+func TestBuilder_BuildChecked_BadScopedFieldNames(t *testing.T) {
+	a, err := NewBuilder().
+		AddConverterFor(checkedDst{}, "Frq", MapString(func(s string) string { return s })).
+		AddConverterForPair(bSrc{}, checkedDst{}, "Mode", MapString(func(s string) string { return s })).
+		AddValidatorFor(checkedDst{}, "Cal", func(any) error { return nil }).
+		AddValidatorForPair(bSrc{}, bDst{}, "Nme", func(any) error { return nil }).
+		BuildChecked()
+	require.Error(t, err)
+	require.NotNil(t, a, "the adapter is still returned")
+	assert.Equal(t, "converter for adapters.checkedDst: no field Frq\n"+
+		"converter for adapters.checkedDst: no field Mode\n"+
+		"validator for adapters.checkedDst: no field Cal\n"+
+		"validator for adapters.bDst: no field Nme", err.Error())
+}
+
+func TestBuilder_BuildChecked_MalformedType(t *testing.T) {
+	_, err := NewBuilder().AddConverterFor(reflect.New(dupJSONType("call")).Elem().Interface(), "Call", MapString(func(s string) string { return s })).BuildChecked()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "converter for")
+}
+
+func TestBuilder_MustBuild(t *testing.T) {
+	assert.NotPanics(t, func() { NewBuilder().AddConverterFor(checkedDst{}, "Call", nil).MustBuild() })
+	assert.PanicsWithError(t, "validator for adapters.checkedDst: no field Bnd", func() {
+		NewBuilder().AddValidatorFor(checkedDst{}, "Bnd", func(any) error { return nil }).MustBuild()
+	})
+}
@@ -0,0 +1,46 @@
+package adapters
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilder_WarmMetadataPresentAfterBuild(t *testing.T) {
+	a := NewBuilder().Warm(bSrc{}, &bDst{}, 42).Build()
+	for _, typ := range []reflect.Type{reflect.TypeOf(bSrc{}), reflect.TypeOf(bDst{})} {
+		_, ok := a.metadataCache.Load(typ)
+		assert.True(t, ok, typ.String())
+	}
+}
+
+func TestBuilder_WarmPairBuildsPlanWithRegistrations(t *testing.T) {
+	var events []CacheEvent
+	a := NewBuilder().
+		WithOptions(WithCacheObserver(func(e CacheEvent) { events = append(events, e) })).
+		AddConverter("Name", MapString(strings.ToUpper)).
+		WarmPair(&bSrc{}, bDst{}).
+		Build()
+	_, ok := a.planCache.Load([2]reflect.Type{reflect.TypeOf(bSrc{}), reflect.TypeOf(bDst{})})
+	require.True(t, ok)
+
+	events = nil
+	d := &bDst{}
+	require.NoError(t, a.Into(d, &bSrc{Name: "m0cmc"}))
+	assert.Equal(t, "M0CMC", d.Name, "the warmed plan includes converters added to the builder")
+	for _, e := range events {
+		assert.True(t, e.Hit, "first Into after Build should only hit caches: %+v", e)
+	}
+}
+
+func TestWarmPair_IgnoresNonStructs(t *testing.T) {
+	a := New()
+	assert.NotPanics(t, func() {
+		a.WarmPair(nil, bDst{})
+		a.WarmPair(1, bDst{})
+		a.WarmPair(bSrc{}, "x")
+	})
+}
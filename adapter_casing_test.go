@@ -0,0 +1,54 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestMapStringUpper_Turkish(t *testing.T) {
+	got, err := MapStringUpper(language.Turkish)("istanbul ılgaz")
+	require.NoError(t, err)
+	assert.Equal(t, "İSTANBUL ILGAZ", got)
+
+	got, err = MapStringUpper(language.MustParse("az-Latn-AZ"))("bakı")
+	require.NoError(t, err)
+	assert.Equal(t, "BAKI", got)
+
+	got, err = MapStringUpper(language.English)("istanbul")
+	require.NoError(t, err)
+	assert.Equal(t, "ISTANBUL", got, "default casing outside Turkish and Azeri")
+}
+
+func TestMapStringLower_Turkish(t *testing.T) {
+	got, err := MapStringLower(language.MustParse("tr-TR"))("DİYARBAKIR")
+	require.NoError(t, err)
+	assert.Equal(t, "diyarbakır", got)
+}
+
+func TestMapStringLower_GreekFinalSigma(t *testing.T) {
+	got, err := MapStringLower(language.Greek)("ΟΔΟΣ")
+	require.NoError(t, err)
+	assert.Equal(t, "οδος", got)
+}
+
+func TestMapStringUpper_Lithuanian(t *testing.T) {
+	got, err := MapStringUpper(language.Lithuanian)("i̇̀")
+	require.NoError(t, err)
+	assert.Equal(t, "Ì", got, "the combining dot above is dropped after i")
+}
+
+func TestMapStringUpper_InAdapter(t *testing.T) {
+	type rec struct{ Name string }
+	a := New()
+	a.RegisterConverter("Name", MapStringUpper(language.Turkish))
+	d := &rec{}
+	require.NoError(t, a.Into(d, &rec{Name: "şişli"}))
+	assert.Equal(t, "ŞİŞLİ", d.Name)
+
+	got, err := MapStringUpper(language.Turkish)(42)
+	require.NoError(t, err)
+	assert.Equal(t, 42, got, "non-strings pass through")
+}
@@ -0,0 +1,59 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type clearADSrc struct {
+	Call string
+}
+
+type clearADDst struct {
+	Call           string
+	AdditionalData null.JSON
+}
+
+type clearADPtrDst struct {
+	Call           string
+	AdditionalData *null.JSON
+}
+
+func staleAD() null.JSON { return null.JSONFrom([]byte(`{"Rig":"stale"}`)) }
+
+func TestClearDestinationAD_DisableMarshal(t *testing.T) {
+	dst := &clearADDst{AdditionalData: staleAD()}
+	require.NoError(t, NewWithOptions(WithDisableMarshalAdditionalData(true)).Into(dst, &clearADSrc{Call: "M0CMC"}))
+	assert.True(t, dst.AdditionalData.Valid, "without the option stale data survives")
+
+	dst = &clearADDst{AdditionalData: staleAD()}
+	a := NewWithOptions(WithDisableMarshalAdditionalData(true), WithClearDestinationAdditionalData(true))
+	require.NoError(t, a.Into(dst, &clearADSrc{Call: "M0CMC"}))
+	assert.Equal(t, clearADDst{Call: "M0CMC"}, *dst)
+}
+
+func TestClearDestinationAD_Merge(t *testing.T) {
+	type src struct{ Call, Ant string }
+	a := NewWithOptions(WithMergeAdditionalData(true), WithClearDestinationAdditionalData(true))
+	dst := &clearADDst{AdditionalData: staleAD()}
+	require.NoError(t, a.Into(dst, &src{Call: "M0CMC", Ant: "dipole"}))
+	assert.JSONEq(t, `{"Ant":"dipole"}`, string(dst.AdditionalData.JSON), "nothing left to merge into")
+}
+
+func TestClearDestinationAD_Pointer(t *testing.T) {
+	stale := staleAD()
+	dst := &clearADPtrDst{AdditionalData: &stale}
+	a := NewWithOptions(WithDisableMarshalAdditionalData(true), WithClearDestinationAdditionalData(true))
+	require.NoError(t, a.Into(dst, &clearADSrc{Call: "M0CMC"}))
+	assert.Nil(t, dst.AdditionalData)
+	assert.True(t, stale.Valid, "the caller's value is not modified")
+}
+
+func TestClearDestinationAD_InPlaceUntouched(t *testing.T) {
+	dst := &clearADDst{Call: "M0CMC", AdditionalData: staleAD()}
+	require.NoError(t, NewWithOptions(WithClearDestinationAdditionalData(true)).Into(dst, dst))
+	assert.JSONEq(t, `{"Rig":"stale"}`, string(dst.AdditionalData.JSON))
+}
@@ -0,0 +1,88 @@
+package adapters
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type coalesceSrc struct {
+	Call      string
+	Email     string
+	WorkEmail string
+	HomeEmail string
+}
+
+type coalesceDst struct {
+	Call           string
+	Email          string
+	AdditionalData null.JSON
+}
+
+func TestCoalesce_FirstNonZero(t *testing.T) {
+	a := New()
+	a.RegisterCoalesce("Email", "WorkEmail", "HomeEmail", "Email")
+	dst := &coalesceDst{}
+	require.NoError(t, a.Into(dst, &coalesceSrc{Call: "M0CMC", HomeEmail: "home@example.com", Email: "old@example.com"}))
+	assert.Equal(t, "home@example.com", dst.Email)
+	assert.JSONEq(t, `{"Email":"old@example.com"}`, string(dst.AdditionalData.JSON), "passed-over candidates stay unmatched")
+
+	dst = &coalesceDst{}
+	require.NoError(t, a.Into(dst, &coalesceSrc{WorkEmail: "work@example.com", HomeEmail: "home@example.com"}))
+	assert.Equal(t, "work@example.com", dst.Email)
+	assert.JSONEq(t, `{"HomeEmail":"home@example.com"}`, string(dst.AdditionalData.JSON))
+}
+
+func TestCoalesce_AllEmpty(t *testing.T) {
+	a := New()
+	a.RegisterCoalesce("Email", "WorkEmail", "HomeEmail")
+	dst := &coalesceDst{Email: "stale@example.com"}
+	require.NoError(t, a.Into(dst, &coalesceSrc{Call: "M0CMC"}))
+	assert.Empty(t, dst.Email, "the first candidate's zero value is copied")
+	assert.False(t, dst.AdditionalData.Valid)
+}
+
+func TestCoalesce_ConverterAndValidatorApply(t *testing.T) {
+	a := New()
+	a.RegisterCoalesce("Email", "WorkEmail", "HomeEmail")
+	a.RegisterConverter("Email", func(v interface{}) (interface{}, error) { return strings.ToLower(v.(string)), nil })
+	var validated []string
+	a.RegisterValidator("Email", func(v interface{}) error {
+		validated = append(validated, v.(string))
+		return nil
+	})
+	dst := &coalesceDst{}
+	require.NoError(t, a.Into(dst, &coalesceSrc{HomeEmail: "Home@Example.com"}))
+	assert.Equal(t, "home@example.com", dst.Email)
+	assert.Equal(t, []string{"home@example.com"}, validated)
+}
+
+func TestCoalesce_UnknownCandidatesAndReport(t *testing.T) {
+	a := New()
+	a.RegisterCoalesce("Email", "Missing", "HomeEmail")
+	r, err := a.IntoReport(&coalesceDst{}, &coalesceSrc{HomeEmail: "home@example.com"})
+	require.NoError(t, err)
+	f, _ := r.Field("HomeEmail")
+	assert.Equal(t, FieldDisposition{Field: "HomeEmail", Disposition: Copied, Dst: "Email"}, f)
+
+	a.RegisterCoalesce("Email", "Missing")
+	dst := &coalesceDst{}
+	require.NoError(t, a.Into(dst, &coalesceSrc{Email: "plain@example.com"}))
+	assert.Equal(t, "plain@example.com", dst.Email, "no usable candidate keeps the name match")
+}
+
+func TestCoalesce_StrictCountsCandidatesAsMapped(t *testing.T) {
+	type dst struct {
+		Call  string
+		Email string
+	}
+	a := NewStrict()
+	a.RegisterCoalesce("Email", "Email", "WorkEmail", "HomeEmail")
+	d := &dst{}
+	require.NoError(t, a.Into(d, &coalesceSrc{Call: "M0CMC", WorkEmail: "work@example.com"}))
+	assert.Equal(t, dst{Call: "M0CMC", Email: "work@example.com"}, *d)
+	assert.True(t, a.CheckMapping(coalesceSrc{}, dst{}).Clean())
+}
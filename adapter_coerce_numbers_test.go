@@ -0,0 +1,56 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type coerceSrc struct {
+	Call           string
+	AdditionalData null.JSON
+}
+
+type coerceDst struct {
+	Call  string
+	Age   int
+	Power float64
+	Count uint8
+	Level int8
+	Name  string
+}
+
+func coerceFrom(js string) *coerceSrc {
+	return &coerceSrc{Call: "M0CMC", AdditionalData: null.JSONFrom([]byte(js))}
+}
+
+func TestCoerceStringNumbers_DisabledByDefault(t *testing.T) {
+	d := &coerceDst{}
+	require.NoError(t, New().Into(d, coerceFrom(`{"Age":"30","Power":"99.5"}`)))
+	assert.Zero(t, d.Age)
+	assert.Zero(t, d.Power)
+}
+
+func TestCoerceStringNumbers_QuotedIntsAndFloats(t *testing.T) {
+	a := NewWithOptions(WithCoerceStringNumbers(true))
+	d := &coerceDst{}
+	require.NoError(t, a.Into(d, coerceFrom(`{"Age":"30","Power":" 99.5 ","Count":"7","Level":"-3","Name":"Marc"}`)))
+	assert.Equal(t, coerceDst{Call: "M0CMC", Age: 30, Power: 99.5, Count: 7, Level: -3, Name: "Marc"}, *d)
+}
+
+func TestCoerceStringNumbers_PlainNumbersUnaffected(t *testing.T) {
+	a := NewWithOptions(WithCoerceStringNumbers(true))
+	d := &coerceDst{}
+	require.NoError(t, a.Into(d, coerceFrom(`{"Age":30,"Power":1.5}`)))
+	assert.Equal(t, 30, d.Age)
+	assert.Equal(t, 1.5, d.Power)
+}
+
+func TestCoerceStringNumbers_BadStringsSkipped(t *testing.T) {
+	a := NewWithOptions(WithCoerceStringNumbers(true))
+	d := &coerceDst{Age: 1, Power: 2, Count: 3, Level: 4}
+	require.NoError(t, a.Into(d, coerceFrom(`{"Age":"thirty","Power":"","Count":"300","Level":"1.5"}`)))
+	assert.Equal(t, coerceDst{Call: "M0CMC", Age: 1, Power: 2, Count: 3, Level: 4}, *d, "non-numeric and out-of-range strings leave fields unchanged")
+}
@@ -0,0 +1,90 @@
+package adapters
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type cmpType struct {
+	Call string
+	Freq int64
+	Band string
+}
+
+type cmpModel struct {
+	Call           string
+	Freq           int64
+	AdditionalData null.JSON
+}
+
+func TestCompile_MatchesInto(t *testing.T) {
+	a := New()
+	fn, err := a.Compile(&cmpType{}, cmpModel{})
+	require.NoError(t, err)
+	s := cmpType{Call: "G0ABC", Freq: 14320000, Band: "20m"}
+
+	got := cmpModel{}
+	require.NoError(t, fn(&got, &s))
+	want := cmpModel{}
+	require.NoError(t, a.Into(&want, &s))
+	assert.Equal(t, want, got)
+	assert.JSONEq(t, `{"Band":"20m"}`, string(got.AdditionalData.JSON))
+}
+
+func TestCompile_RejectsOtherTypes(t *testing.T) {
+	a := New()
+	fn, err := a.Compile(cmpType{}, cmpModel{})
+	require.NoError(t, err)
+	assert.Error(t, fn(&cmpType{}, &cmpModel{}))
+	assert.Error(t, fn(cmpModel{}, &cmpType{}))
+	assert.Error(t, fn(nil, &cmpType{}))
+	assert.Error(t, fn((*cmpModel)(nil), &cmpType{}))
+}
+
+func TestCompile_InvalidTypes(t *testing.T) {
+	a := New()
+	_, err := a.Compile(nil, cmpModel{})
+	assert.Error(t, err)
+	_, err = a.Compile(1, cmpModel{})
+	assert.Error(t, err)
+}
+
+func TestCompile_PicksUpLaterRegistrations(t *testing.T) {
+	a := New()
+	fn, err := a.Compile(cmpType{}, cmpModel{})
+	require.NoError(t, err)
+	a.RegisterConverter("Call", func(src interface{}) (interface{}, error) {
+		return strings.ToLower(src.(string)), nil
+	})
+	d := cmpModel{}
+	require.NoError(t, fn(&d, &cmpType{Call: "G0ABC"}))
+	assert.Equal(t, "g0abc", d.Call)
+}
+
+func TestCompile_Concurrent(t *testing.T) {
+	a := New()
+	fn, err := a.Compile(cmpType{}, cmpModel{})
+	require.NoError(t, err)
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				call := fmt.Sprintf("C%d", i)
+				d := cmpModel{}
+				if assert.NoError(t, fn(&d, &cmpType{Call: call, Freq: int64(j)})) {
+					assert.Equal(t, call, d.Call)
+					assert.Equal(t, int64(j), d.Freq)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,109 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ctxSrc struct {
+	Call string
+	Grid string
+}
+
+type ctxDst struct {
+	Call string
+	Grid string
+}
+
+func TestIntoCtx_PassesContext(t *testing.T) {
+	type key struct{}
+	a := New()
+	a.RegisterContextConverter("Call", func(ctx context.Context, src interface{}) (interface{}, error) {
+		return src.(string) + ctx.Value(key{}).(string), nil
+	})
+	ctx := context.WithValue(context.Background(), key{}, "/P")
+	d := ctxDst{}
+	require.NoError(t, a.IntoCtx(ctx, &d, &ctxSrc{Call: "G0ABC", Grid: "IO91"}))
+	assert.Equal(t, ctxDst{Call: "G0ABC/P", Grid: "IO91"}, d)
+}
+
+func TestIntoCtx_IntoUsesBackground(t *testing.T) {
+	a := New()
+	a.RegisterContextConverter("Call", func(ctx context.Context, src interface{}) (interface{}, error) {
+		require.NotNil(t, ctx)
+		return strings.ToLower(src.(string)), nil
+	})
+	d := ctxDst{}
+	require.NoError(t, a.Into(&d, &ctxSrc{Call: "G0ABC"}))
+	assert.Equal(t, "g0abc", d.Call)
+}
+
+func TestIntoCtx_Precedence(t *testing.T) {
+	a := New()
+	a.RegisterConverter("Call", func(interface{}) (interface{}, error) { return "global", nil })
+	a.RegisterContextConverter("Call", func(context.Context, interface{}) (interface{}, error) { return "ctx", nil })
+	d := ctxDst{}
+	require.NoError(t, a.Into(&d, &ctxSrc{}))
+	assert.Equal(t, "ctx", d.Call)
+
+	a.RegisterConverterFor(ctxDst{}, "Call", func(interface{}) (interface{}, error) { return "dst", nil })
+	require.NoError(t, a.Into(&d, &ctxSrc{}))
+	assert.Equal(t, "dst", d.Call)
+}
+
+func TestIntoCtx_CancelledBeforeStart(t *testing.T) {
+	a := New()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	d := ctxDst{}
+	err := a.IntoCtx(ctx, &d, &ctxSrc{Call: "x"})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, ctxDst{}, d)
+}
+
+func TestIntoCtx_ConverterError(t *testing.T) {
+	a := New()
+	a.RegisterContextConverter("Call", func(context.Context, interface{}) (interface{}, error) {
+		return nil, errors.New("lookup failed")
+	})
+	err := a.IntoCtx(context.Background(), &ctxDst{}, &ctxSrc{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "adapting field Call")
+}
+
+func TestIntoTimeout_DeadlineExceeded(t *testing.T) {
+	a := New()
+	a.RegisterContextConverter("Call", func(ctx context.Context, src interface{}) (interface{}, error) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Second):
+			return src, nil
+		}
+	})
+	start := time.Now()
+	err := a.IntoTimeout(10*time.Millisecond, &ctxDst{}, &ctxSrc{Call: "x"})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+}
+
+func TestIntoTimeout_WithinBound(t *testing.T) {
+	a := New()
+	a.RegisterContextConverter("Call", func(ctx context.Context, src interface{}) (interface{}, error) {
+		return strings.ToUpper(src.(string)), nil
+	})
+	d := ctxDst{}
+	require.NoError(t, a.IntoTimeout(time.Second, &d, &ctxSrc{Call: "g0abc"}))
+	assert.Equal(t, "G0ABC", d.Call)
+}
+
+func TestIntoCtx_NilContext(t *testing.T) {
+	a := New()
+	assert.Error(t, a.IntoCtx(nil, &ctxDst{}, &ctxSrc{}))
+}
@@ -0,0 +1,57 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errBadFreq = errors.New("bad frequency")
+
+func failingConverter(interface{}) (interface{}, error) { return nil, errBadFreq }
+
+func TestConverterError_IncludesValueAndType(t *testing.T) {
+	type S struct{ Freq string }
+	type D struct{ Freq int64 }
+	a := New()
+	a.RegisterConverter("Freq", failingConverter)
+	err := a.Into(&D{}, &S{Freq: "not-a-freq"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `converter for Freq failed on "not-a-freq" (string)`)
+	assert.ErrorIs(t, err, errBadFreq)
+}
+
+func TestConverterError_NonString(t *testing.T) {
+	type S struct{ Freq int }
+	type D struct{ Freq string }
+	a := New()
+	a.RegisterConverter("Freq", failingConverter)
+	err := a.Into(&D{}, &S{Freq: 42})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed on 42 (int)")
+}
+
+func TestConverterError_TruncatesLongValues(t *testing.T) {
+	type S struct{ Note string }
+	type D struct{ Note string }
+	a := New()
+	a.RegisterConverter("Note", failingConverter)
+	err := a.Into(&D{}, &S{Note: strings.Repeat("x", 10*maxRenderedValue)})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"`+strings.Repeat("x", maxRenderedValue)+`..." (string)`)
+	assert.NotContains(t, err.Error(), strings.Repeat("x", maxRenderedValue+1))
+}
+
+func TestConverterError_ContextConverter(t *testing.T) {
+	type S struct{ Call string }
+	type D struct{ Call string }
+	a := New()
+	a.RegisterContextConverter("Call", func(context.Context, interface{}) (interface{}, error) { return nil, errBadFreq })
+	err := a.Into(&D{}, &S{Call: "M0CMC"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `converter for Call failed on "M0CMC" (string)`)
+}
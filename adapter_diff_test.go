@@ -0,0 +1,72 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntoDiff_ReportsChangedFields(t *testing.T) {
+	a := New()
+	type S struct {
+		Name string
+		Band string
+		Mode string
+	}
+	type D struct {
+		Name string
+		Band string
+		Mode string
+	}
+	s := S{Name: "G0ABC", Band: "20m", Mode: "SSB"}
+	d := D{Name: "G0ABC", Band: "40m", Mode: "CW"}
+	changes, err := a.IntoDiff(&d, &s)
+	require.NoError(t, err)
+	assert.Equal(t, []FieldChange{
+		{Field: "Band", Old: "40m", New: "20m"},
+		{Field: "Mode", Old: "CW", New: "SSB"},
+	}, changes)
+}
+
+func TestIntoDiff_NoChanges(t *testing.T) {
+	a := New()
+	type T struct{ Name string }
+	s := T{Name: "x"}
+	d := T{Name: "x"}
+	changes, err := a.IntoDiff(&d, &s)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestIntoDiff_IncludesAdditionalData(t *testing.T) {
+	a := New()
+	type S struct {
+		Name string
+		Grid string
+	}
+	type D struct {
+		Name           string
+		AdditionalData null.JSON
+	}
+	s := S{Name: "x", Grid: "IO91"}
+	d := D{Name: "x"}
+	changes, err := a.IntoDiff(&d, &s)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "AdditionalData", changes[0].Field)
+}
+
+func TestIntoDiff_Errors(t *testing.T) {
+	a := New()
+	type T struct{ Name string }
+	s := T{}
+	_, err := a.IntoDiff(nil, &s)
+	assert.Error(t, err)
+	_, err = a.IntoDiff(T{}, &s)
+	assert.Error(t, err)
+	d := T{}
+	_, err = a.IntoDiff(&d, s)
+	assert.Error(t, err)
+}
@@ -0,0 +1,50 @@
+package adapters
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dupJSONType builds a struct whose two fields share the same json tag. It is built at runtime because
+// go vet rejects duplicate json tags in struct literals.
+func dupJSONType(tag string) reflect.Type {
+	return reflect.StructOf([]reflect.StructField{
+		{Name: "Call", Type: reflect.TypeOf(""), Tag: reflect.StructTag(`json:"` + tag + `"`)},
+		{Name: "CallAlt", Type: reflect.TypeOf(""), Tag: reflect.StructTag(`json:"` + tag + `,omitempty"`)},
+	})
+}
+
+func TestDuplicateJSONTag_Destination(t *testing.T) {
+	a := New()
+	type S struct{ Call string }
+	s := S{Call: "G0ABC"}
+	d := reflect.New(dupJSONType("call"))
+	err := a.Into(d.Interface(), &s)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"call"`)
+	assert.Contains(t, err.Error(), "CallAlt")
+}
+
+func TestDuplicateJSONTag_Source(t *testing.T) {
+	a := New()
+	type D struct{ Call string }
+	s := reflect.New(dupJSONType("x"))
+	d := D{}
+	assert.Error(t, a.Into(&d, s.Interface()))
+}
+
+func TestDuplicateJSONTag_DashIsNotDuplicate(t *testing.T) {
+	a := New()
+	type S struct{ A string }
+	type D struct {
+		A string `json:"-"`
+		B string `json:"-"`
+	}
+	s := S{A: "v"}
+	d := D{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, "v", d.A)
+}
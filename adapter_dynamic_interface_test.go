@@ -0,0 +1,86 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type spotPayload struct {
+	Call string
+	Freq string
+}
+
+type qsoPayload struct {
+	Call string
+	Band string
+	Mode string
+}
+
+type eventEnvelope struct {
+	Kind    string
+	Payload interface{}
+}
+
+type spotPayloadModel struct {
+	Call string
+	Freq string
+	Band string
+}
+
+type spotEvent struct {
+	Kind    string
+	Payload *spotPayloadModel
+}
+
+type rawEvent struct {
+	Kind    string
+	Payload string
+}
+
+func TestDynamicInterface_ConcreteStructTypes(t *testing.T) {
+	a := NewWithOptions(WithDynamicInterfaceSources(true))
+
+	dst := &spotEvent{}
+	require.NoError(t, a.Into(dst, &eventEnvelope{Kind: "spot", Payload: spotPayload{Call: "M0CMC", Freq: "14.074"}}))
+	require.NotNil(t, dst.Payload)
+	assert.Equal(t, spotPayloadModel{Call: "M0CMC", Freq: "14.074"}, *dst.Payload)
+
+	dst = &spotEvent{}
+	require.NoError(t, a.Into(dst, &eventEnvelope{Kind: "qso", Payload: &qsoPayload{Call: "G4ABC", Band: "20m", Mode: "CW"}}))
+	require.NotNil(t, dst.Payload)
+	assert.Equal(t, spotPayloadModel{Call: "G4ABC", Band: "20m"}, *dst.Payload, "a pointer payload is adapted too")
+}
+
+func TestDynamicInterface_AssignableValue(t *testing.T) {
+	a := NewWithOptions(WithDynamicInterfaceSources(true))
+	model := &spotPayloadModel{Call: "M0CMC"}
+	dst := &spotEvent{}
+	require.NoError(t, a.Into(dst, &eventEnvelope{Payload: model}))
+	assert.Same(t, model, dst.Payload, "a payload already of the destination type is copied")
+
+	raw := &rawEvent{}
+	require.NoError(t, a.Into(raw, &eventEnvelope{Payload: "hello"}))
+	assert.Equal(t, "hello", raw.Payload)
+}
+
+func TestDynamicInterface_NilAndIncompatible(t *testing.T) {
+	a := NewWithOptions(WithDynamicInterfaceSources(true))
+	dst := &spotEvent{Payload: &spotPayloadModel{Call: "old"}}
+	require.NoError(t, a.Into(dst, &eventEnvelope{Payload: nil}))
+	assert.Nil(t, dst.Payload, "a nil interface resets the destination")
+
+	dst = &spotEvent{Payload: &spotPayloadModel{Call: "old"}}
+	require.NoError(t, a.Into(dst, &eventEnvelope{Payload: 42}))
+	assert.Equal(t, "old", dst.Payload.Call, "an incompatible dynamic type is skipped")
+
+	err := NewStrict(WithDynamicInterfaceSources(true)).Into(&spotEvent{}, &eventEnvelope{Payload: 42})
+	require.Error(t, err, "strict mode reports it like any incompatible field")
+}
+
+func TestDynamicInterface_DisabledByDefault(t *testing.T) {
+	dst := &spotEvent{}
+	require.NoError(t, New().Into(dst, &eventEnvelope{Payload: spotPayload{Call: "M0CMC"}}))
+	assert.Nil(t, dst.Payload)
+}
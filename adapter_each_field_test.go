@@ -0,0 +1,67 @@
+package adapters
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type efInner struct {
+	Band string `json:"band"`
+}
+
+type efQso struct {
+	ID int64 `adapter:"readonly"`
+	efInner
+	Details        efInner `adapter:"flatten"`
+	Call           string  `json:"call"`
+	Password       string  `adapter:"ignore"`
+	Hash           string  `adapter:"writeonly"`
+	secret         string
+	AdditionalData null.JSON
+}
+
+func collectFields(a *Adapter, typ any) []FieldDescriptor {
+	var out []FieldDescriptor
+	a.EachField(typ, func(fd FieldDescriptor) { out = append(out, fd) })
+	return out
+}
+
+func TestEachField_Descriptors(t *testing.T) {
+	fields := collectFields(New(), efQso{})
+	require.Len(t, fields, 7)
+	assert.Equal(t, FieldDescriptor{Name: "ID", Type: reflect.TypeOf(int64(0)), ReadOnly: true, IndexPath: []int{0}}, fields[0])
+	assert.Equal(t, FieldDescriptor{Name: "Band", JSONName: "band", Type: reflect.TypeOf(""), IndexPath: []int{1, 0}}, fields[1])
+	assert.Equal(t, []int{2, 0}, fields[2].IndexPath)
+	assert.Equal(t, "call", fields[3].JSONName)
+	assert.True(t, fields[4].Ignored)
+	assert.True(t, fields[5].WriteOnly)
+	assert.Equal(t, "AdditionalData", fields[6].Name)
+	assert.True(t, fields[6].IsAdditionalData)
+
+	v := reflect.ValueOf(efQso{Details: efInner{Band: "20m"}})
+	assert.Equal(t, "20m", v.FieldByIndex(fields[2].IndexPath).Interface())
+}
+
+func TestEachField_AcceptsPointerAndType(t *testing.T) {
+	a := New()
+	want := collectFields(a, efQso{})
+	assert.Equal(t, want, collectFields(a, &efQso{}))
+	assert.Equal(t, want, collectFields(a, reflect.TypeOf(efQso{})))
+}
+
+func TestEachField_DescriptorsAreCopies(t *testing.T) {
+	a := New()
+	first := collectFields(a, efQso{})
+	first[1].IndexPath[0] = 99
+	assert.Equal(t, []int{1, 0}, collectFields(a, efQso{})[1].IndexPath)
+}
+
+func TestEachField_NonStruct(t *testing.T) {
+	a := New()
+	assert.Empty(t, collectFields(a, 42))
+	assert.Empty(t, collectFields(a, nil))
+}
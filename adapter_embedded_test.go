@@ -249,15 +249,14 @@ func TestAdapter_AdditionalDataToPointerEmbedded(t *testing.T) {
 		AdditionalData: null.JSONFrom(jsonData),
 	}
 
-	// Initialize the pointer embedded field
-	dst := &PersonDst{
-		Details: &Details{},
-	}
+	// The nil embedded pointer is allocated on demand
+	dst := &PersonDst{}
 
 	err = adapter.Into(dst, src)
 	require.NoError(t, err)
 
 	assert.Equal(t, "Frank", dst.Name)
+	require.NotNil(t, dst.Details)
 	assert.Equal(t, 30, dst.Details.Age)
 	assert.Equal(t, 170, dst.Details.Height)
 }
@@ -469,3 +468,78 @@ func TestAdapter_RoundTripPointerEmbeddedAdditionalData(t *testing.T) {
 	assert.Equal(t, true, dst.Details.Active)
 	assert.Equal(t, "iris@example.com", dst.Email)
 }
+
+type Tree struct {
+	*Tree
+	Value int
+}
+
+type loopA struct {
+	*loopB
+	A int
+}
+
+type loopB struct {
+	*loopA
+	B int
+}
+
+func TestAdapter_SelfReferentialEmbeddedPointer(t *testing.T) {
+	adapter := New()
+	typ := reflect.TypeOf(Tree{})
+	assert.Equal(t, 2, adapter.countFields(typ))
+	meta := adapter.getOrBuildMetadata(typ)
+	require.Len(t, meta.fields, 2)
+	assert.Equal(t, "Tree", meta.fields[0].name)
+
+	child := &Tree{Value: 1}
+	src := Tree{Tree: child, Value: 2}
+	dst := Tree{}
+	require.NoError(t, adapter.Into(&dst, &src))
+	assert.Equal(t, 2, dst.Value)
+	assert.Same(t, child, dst.Tree)
+}
+
+func TestAdapter_MutuallyRecursiveEmbeddedPointers(t *testing.T) {
+	adapter := New()
+	meta := adapter.getOrBuildMetadata(reflect.TypeOf(loopA{}))
+	// loopB.B is flattened; the cyclic *loopA becomes a regular field, skipped because it is unexported
+	names := make([]string, 0, len(meta.fields))
+	for _, f := range meta.fields {
+		names = append(names, f.name)
+	}
+	assert.Equal(t, []string{"B", "A"}, names)
+
+	src := loopA{loopB: &loopB{B: 3}, A: 4}
+	dst := loopA{loopB: &loopB{}}
+	require.NoError(t, adapter.Into(&dst, &src))
+	assert.Equal(t, 3, dst.B)
+	assert.Equal(t, 4, dst.A)
+}
+
+// AdditionalData keys that do not match an embedded field leave the nil pointer untouched
+func TestAdapter_AdditionalDataNoMatchKeepsNilEmbedded(t *testing.T) {
+	adapter := New()
+
+	type Details struct {
+		Age int
+	}
+
+	type PersonSrc struct {
+		AdditionalData null.JSON
+	}
+
+	type PersonDst struct {
+		Name string
+		*Details
+	}
+
+	jsonData, err := json.Marshal(map[string]interface{}{"Age": "not a number", "Other": 1})
+	require.NoError(t, err)
+
+	src := &PersonSrc{AdditionalData: null.JSONFrom(jsonData)}
+	dst := &PersonDst{}
+
+	require.NoError(t, adapter.Into(dst, src))
+	assert.Nil(t, dst.Details)
+}
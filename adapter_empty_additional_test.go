@@ -0,0 +1,70 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	boilertypes "github.com/aarondl/sqlboiler/v4/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type eadSrc struct {
+	Name string
+}
+
+type eadNull struct {
+	Name           string
+	AdditionalData null.JSON
+}
+
+type eadBoiler struct {
+	Name           string
+	AdditionalData boilertypes.JSON
+}
+
+func TestEmptyAdditionalData_Styles(t *testing.T) {
+	cases := []struct {
+		name       string
+		style      EmptyAdditionalData
+		wantNull   null.JSON
+		wantBoiler boilertypes.JSON
+	}{
+		{"null (default)", EmptyAsNull, null.JSON{}, boilertypes.JSON(nil)},
+		{"object", EmptyAsObject, null.JSONFrom([]byte("{}")), boilertypes.JSON("{}")},
+		{"json null", EmptyAsJSONNull, null.JSONFrom([]byte("null")), boilertypes.JSON("null")},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := NewWithOptions(WithEmptyAdditionalData(tc.style))
+			s := eadSrc{Name: "x"}
+
+			dn := eadNull{AdditionalData: null.JSONFrom([]byte(`{"stale":1}`))}
+			require.NoError(t, a.Into(&dn, &s))
+			assert.Equal(t, tc.wantNull.Valid, dn.AdditionalData.Valid)
+			assert.Equal(t, tc.wantNull.JSON, dn.AdditionalData.JSON)
+
+			db := eadBoiler{AdditionalData: boilertypes.JSON(`{"stale":1}`)}
+			require.NoError(t, a.Into(&db, &s))
+			assert.Equal(t, tc.wantBoiler, db.AdditionalData)
+		})
+	}
+}
+
+func TestEmptyAdditionalData_DefaultIsNull(t *testing.T) {
+	a := New()
+	d := eadBoiler{}
+	require.NoError(t, a.Into(&d, &eadSrc{Name: "x"}))
+	assert.Nil(t, d.AdditionalData)
+}
+
+func TestEmptyAdditionalData_IgnoredWhenFieldsRemain(t *testing.T) {
+	a := NewWithOptions(WithEmptyAdditionalData(EmptyAsObject))
+	type Src struct {
+		Name string
+		Rig  string
+	}
+	d := eadBoiler{}
+	require.NoError(t, a.Into(&d, &Src{Name: "x", Rig: "r"}))
+	assert.JSONEq(t, `{"Rig":"r"}`, string(d.AdditionalData))
+}
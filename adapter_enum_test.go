@@ -0,0 +1,74 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var modeIDs = map[string]int64{"SSB": 1, "CW": 2, "FT8": 3}
+
+type enType struct {
+	Mode string `json:"mode"`
+}
+
+type enModel struct {
+	ModeID int32 `json:"mode"`
+}
+
+func TestRegisterEnum_BothDirections(t *testing.T) {
+	a := New()
+	require.NoError(t, a.RegisterEnum("ModeID", modeIDs))
+	s := enType{Mode: "CW"}
+	m := enModel{}
+	require.NoError(t, a.Into(&m, &s))
+	assert.Equal(t, int32(2), m.ModeID)
+
+	back := enType{}
+	require.NoError(t, a.Into(&back, &m))
+	assert.Equal(t, "CW", back.Mode)
+}
+
+func TestRegisterEnum_UnknownErrors(t *testing.T) {
+	a := New()
+	require.NoError(t, a.RegisterEnum("ModeID", modeIDs))
+	s := enType{Mode: "RTTY"}
+	m := enModel{}
+	err := a.Into(&m, &s)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "RTTY")
+
+	m2 := enModel{ModeID: 99}
+	assert.Error(t, a.Into(&enType{}, &m2))
+}
+
+func TestRegisterEnum_UnknownAsZero(t *testing.T) {
+	a := New()
+	require.NoError(t, a.RegisterEnum("ModeID", modeIDs, WithEnumUnknownAsZero()))
+	s := enType{Mode: "RTTY"}
+	m := enModel{ModeID: 5}
+	require.NoError(t, a.Into(&m, &s))
+	assert.Equal(t, int32(0), m.ModeID)
+
+	m2 := enModel{ModeID: 99}
+	d := enType{Mode: "old"}
+	require.NoError(t, a.Into(&d, &m2))
+	assert.Equal(t, "", d.Mode)
+}
+
+func TestRegisterEnum_NotBijective(t *testing.T) {
+	a := New()
+	err := a.RegisterEnum("Mode", map[string]int64{"USB": 1, "LSB": 1})
+	assert.Error(t, err)
+}
+
+func TestRegisterEnum_ExplicitConverterWins(t *testing.T) {
+	a := New()
+	require.NoError(t, a.RegisterEnum("ModeID", modeIDs))
+	a.RegisterConverter("ModeID", func(v any) (any, error) { return int32(42), nil })
+	s := enType{Mode: "CW"}
+	m := enModel{}
+	require.NoError(t, a.Into(&m, &s))
+	assert.Equal(t, int32(42), m.ModeID)
+}
@@ -0,0 +1,68 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type factorySrc struct {
+	Call string
+	Band string
+}
+
+type factoryDst struct {
+	Call    string
+	Band    string
+	Mode    string
+	Station string
+}
+
+func newFactoryDst() interface{} {
+	return &factoryDst{Band: "20m", Mode: "SSB", Station: "home"}
+}
+
+func TestMakeWithFactory_PrepopulatedFieldsPartiallyOverwritten(t *testing.T) {
+	a := New()
+	a.RegisterFactory(factoryDst{}, newFactoryDst)
+
+	d, err := MakeWithFactory[factoryDst](a, &factorySrc{Call: "M0CMC", Band: "40m"})
+	require.NoError(t, err)
+	assert.Equal(t, factoryDst{Call: "M0CMC", Band: "40m", Mode: "SSB", Station: "home"}, *d)
+}
+
+func TestMakeWithFactory_ReturnsFactoryPointer(t *testing.T) {
+	pooled := &factoryDst{Station: "pooled"}
+	a := New().WithFactory(&factoryDst{}, func() interface{} { return pooled })
+	d, err := MakeWithFactory[factoryDst](a, &factorySrc{Call: "G4ABC"})
+	require.NoError(t, err)
+	assert.Same(t, pooled, d)
+	assert.Equal(t, "G4ABC", pooled.Call)
+}
+
+func TestMakeWithFactory_NoFactoryUsesZeroValue(t *testing.T) {
+	d, err := MakeWithFactory[factoryDst](New(), &factorySrc{Call: "M0CMC"})
+	require.NoError(t, err)
+	assert.Equal(t, factoryDst{Call: "M0CMC"}, *d)
+}
+
+func TestMakeWithFactory_WrongFactoryResult(t *testing.T) {
+	a := New()
+	a.RegisterFactory(factoryDst{}, func() interface{} { return factoryDst{} })
+	_, err := MakeWithFactory[factoryDst](a, &factorySrc{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "got adapters.factoryDst")
+
+	a.RegisterFactory(factoryDst{}, func() interface{} { return (*factoryDst)(nil) })
+	_, err = MakeWithFactory[factoryDst](a, &factorySrc{})
+	assert.Error(t, err)
+}
+
+func TestMakeWithFactory_IntoIgnoresFactories(t *testing.T) {
+	a := New()
+	a.RegisterFactory(factoryDst{}, newFactoryDst)
+	d := &factoryDst{}
+	require.NoError(t, a.Into(d, &factorySrc{Call: "M0CMC"}))
+	assert.Empty(t, d.Mode)
+}
@@ -0,0 +1,81 @@
+package adapters
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fbSrc struct {
+	Power any
+	Grid  []string
+	Note  struct{ Text string }
+}
+
+type fbDst struct {
+	Power string
+	Grid  string
+	Note  string
+}
+
+func TestFallbackConverter_ProducesValue(t *testing.T) {
+	var seen []reflect.Type
+	a := NewWithOptions(WithFallbackConverter(func(src interface{}, dstType reflect.Type) (interface{}, error) {
+		seen = append(seen, dstType)
+		return fmt.Sprint(src), nil
+	}))
+	s := fbSrc{Power: 100, Grid: []string{"IO91"}, Note: struct{ Text string }{"hi"}}
+	d := fbDst{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, "100", d.Power)
+	assert.Equal(t, "[IO91]", d.Grid)
+	assert.Equal(t, "{hi}", d.Note)
+	assert.Len(t, seen, 3)
+}
+
+func TestFallbackConverter_Skip(t *testing.T) {
+	a := NewWithOptions(WithFallbackConverter(func(src interface{}, dstType reflect.Type) (interface{}, error) {
+		return nil, Skip
+	}))
+	s := fbSrc{Power: 5}
+	d := fbDst{Power: "keep"}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, "keep", d.Power)
+}
+
+func TestFallbackConverter_ErrorAborts(t *testing.T) {
+	a := NewWithOptions(WithFallbackConverter(func(src interface{}, dstType reflect.Type) (interface{}, error) {
+		return nil, errors.New("no way")
+	}))
+	s := fbSrc{}
+	d := fbDst{}
+	err := a.Into(&d, &s)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no way")
+}
+
+func TestFallbackConverter_WrongTypeErrors(t *testing.T) {
+	a := NewWithOptions(WithFallbackConverter(func(src interface{}, dstType reflect.Type) (interface{}, error) {
+		return 1, nil
+	}))
+	s := fbSrc{}
+	d := fbDst{}
+	assert.Error(t, a.Into(&d, &s))
+}
+
+func TestFallbackConverter_NotUsedForCompatibleFields(t *testing.T) {
+	called := false
+	a := NewWithOptions(WithFallbackConverter(func(src interface{}, dstType reflect.Type) (interface{}, error) {
+		called = true
+		return nil, Skip
+	}))
+	type T struct{ Name string }
+	s := T{Name: "x"}
+	d := T{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.False(t, called)
+}
@@ -0,0 +1,94 @@
+package adapters
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fanoutSrc struct {
+	FreqTx         string
+	Call           string
+	AdditionalData null.JSON
+}
+
+type fanoutDst struct {
+	Freq           string
+	FreqRx         int64
+	FreqMHz        float64
+	Call           string
+	AdditionalData null.JSON
+}
+
+func TestFieldFanout_TwoDestinations(t *testing.T) {
+	a := New()
+	a.RegisterFieldFanout("FreqTx", "Freq", "FreqRx")
+	a.RegisterConverter("FreqRx", func(v interface{}) (interface{}, error) {
+		return strconv.ParseInt(v.(string), 10, 64)
+	})
+	dst := &fanoutDst{}
+	require.NoError(t, a.Into(dst, &fanoutSrc{FreqTx: "14320000", Call: "M0CMC"}))
+	assert.Equal(t, "14320000", dst.Freq)
+	assert.Equal(t, int64(14320000), dst.FreqRx)
+	assert.Equal(t, "M0CMC", dst.Call)
+	assert.False(t, dst.AdditionalData.Valid, "the fanned-out source field is processed, not marshaled")
+}
+
+func TestFieldFanout_ThreeDestinationsOfDifferentTypes(t *testing.T) {
+	a := New().
+		WithFieldFanout("FreqTx", "Freq", "FreqRx", "FreqMHz").
+		WithConverter("FreqRx", func(v interface{}) (interface{}, error) {
+			return strconv.ParseInt(v.(string), 10, 64)
+		}).
+		WithConverter("FreqMHz", func(v interface{}) (interface{}, error) {
+			hz, err := strconv.ParseFloat(v.(string), 64)
+			return hz / 1e6, err
+		})
+	dst := &fanoutDst{}
+	require.NoError(t, a.Into(dst, &fanoutSrc{FreqTx: "7074000"}))
+	assert.Equal(t, fanoutDst{Freq: "7074000", FreqRx: 7074000, FreqMHz: 7.074}, *dst)
+}
+
+func TestFieldFanout_ReplacesNameMatchAndValidatesEachDestination(t *testing.T) {
+	type S struct {
+		Freq   string
+		FreqTx string
+	}
+	type D struct {
+		Freq   string
+		FreqRx string
+	}
+	errEmpty := errors.New("empty")
+	a := New()
+	a.RegisterFieldFanout("FreqTx", "Freq", "FreqRx")
+	a.RegisterValidator("FreqRx", func(v interface{}) error {
+		if v.(string) == "" {
+			return errEmpty
+		}
+		return nil
+	})
+	dst := &D{}
+	require.NoError(t, a.Into(dst, &S{Freq: "ignored", FreqTx: "14074000"}))
+	assert.Equal(t, D{Freq: "14074000", FreqRx: "14074000"}, *dst)
+
+	assert.ErrorIs(t, a.Into(&D{}, &S{Freq: "x"}), errEmpty)
+}
+
+func TestFieldFanout_UnknownFieldsSkippedAndCheckMapping(t *testing.T) {
+	a := New()
+	a.RegisterFieldFanout("FreqTx", "Freq", "Missing")
+	a.RegisterFieldFanout("Nope", "Call")
+	dst := &fanoutDst{}
+	require.NoError(t, a.Into(dst, &fanoutSrc{FreqTx: "1", Call: "G4ABC"}))
+	assert.Equal(t, "1", dst.Freq)
+	assert.Equal(t, "G4ABC", dst.Call)
+
+	r := a.CheckMapping(fanoutSrc{}, fanoutDst{})
+	require.NoError(t, r.Err)
+	assert.Contains(t, r.Matched, "Freq")
+	assert.Empty(t, r.ToAdditionalData)
+}
@@ -0,0 +1,69 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ffSrc struct {
+	Call           string
+	Name           string
+	Email          string
+	Notes          string
+	AdditionalData null.JSON
+}
+
+type ffDst struct {
+	Call  string
+	Name  string
+	Email string
+	Notes string `adapter:"ignore"`
+}
+
+func fullSrc() *ffSrc {
+	return &ffSrc{Call: "M0CMC", Name: "Marc", Email: "m@example.com", Notes: "n"}
+}
+
+func TestFieldFilter_Include(t *testing.T) {
+	d := &ffDst{}
+	require.NoError(t, NewWithOptions(WithIncludeFields("Call", "Name")).Into(d, fullSrc()))
+	assert.Equal(t, ffDst{Call: "M0CMC", Name: "Marc"}, *d)
+}
+
+func TestFieldFilter_Exclude(t *testing.T) {
+	d := &ffDst{}
+	require.NoError(t, NewWithOptions(WithExcludeFields("Email")).Into(d, fullSrc()))
+	assert.Equal(t, ffDst{Call: "M0CMC", Name: "Marc"}, *d)
+}
+
+func TestFieldFilter_ExcludeWinsOverInclude(t *testing.T) {
+	a := NewWithOptions(WithIncludeFields("Call", "Email"), WithExcludeFields("Email"))
+	d := &ffDst{}
+	require.NoError(t, a.Into(d, fullSrc()))
+	assert.Equal(t, ffDst{Call: "M0CMC"}, *d)
+}
+
+func TestFieldFilter_IgnoreTagWinsOverInclude(t *testing.T) {
+	a := NewWithOptions(WithIncludeFields("Call", "Notes"))
+	d := &ffDst{}
+	require.NoError(t, a.Into(d, fullSrc()))
+	assert.Equal(t, ffDst{Call: "M0CMC"}, *d)
+}
+
+func TestFieldFilter_AppliesToAdditionalData(t *testing.T) {
+	a := NewWithOptions(WithExcludeFields("Email"), WithOverwritePolicy(PreferAdditionalData))
+	src := &ffSrc{Call: "M0CMC", AdditionalData: null.JSONFrom([]byte(`{"Name":"Marc","Email":"m@example.com"}`))}
+	d := &ffDst{}
+	require.NoError(t, a.Into(d, src))
+	assert.Equal(t, ffDst{Call: "M0CMC", Name: "Marc"}, *d)
+}
+
+func TestFieldFilter_FilteredFieldsAreNotDestinationOnly(t *testing.T) {
+	type S struct{ Call string }
+	r := NewWithOptions(WithIncludeFields("Call")).CheckMapping(S{}, ffDst{})
+	require.NoError(t, r.Err)
+	assert.True(t, r.Clean(), "%+v", r)
+}
@@ -0,0 +1,74 @@
+package adapters
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fpSrc struct {
+	Call string
+	Freq int64
+	Rig  string
+}
+
+type fpDst struct {
+	Call           string
+	Freq           string
+	AdditionalData null.JSON
+}
+
+func mustFingerprint(t *testing.T, a *Adapter) string {
+	t.Helper()
+	fp, err := a.PlanFingerprint(fpSrc{}, &fpDst{})
+	require.NoError(t, err)
+	return fp
+}
+
+func toStr(v interface{}) (interface{}, error) { return "x", nil }
+
+func TestPlanFingerprint_StableAcrossAdapters(t *testing.T) {
+	build := func() *Adapter { return New().WithConverter("Freq", toStr) }
+	a, b := build(), build()
+	fa := mustFingerprint(t, a)
+	assert.Len(t, fa, 64)
+	assert.Equal(t, fa, mustFingerprint(t, a))
+	assert.Equal(t, fa, mustFingerprint(t, b))
+
+	// a different function in the same scope keeps the fingerprint
+	c := New().WithConverter("Freq", func(v interface{}) (interface{}, error) { return "y", nil })
+	assert.Equal(t, fa, mustFingerprint(t, c))
+}
+
+func TestPlanFingerprint_ChangesWithRegistrations(t *testing.T) {
+	a := New()
+	before := mustFingerprint(t, a)
+	a.RegisterConverter("Freq", toStr)
+	global := mustFingerprint(t, a)
+	assert.NotEqual(t, before, global)
+
+	// same generation count, different scope
+	b := New()
+	b.RegisterConverterFor(fpDst{}, "Freq", toStr)
+	assert.NotEqual(t, global, mustFingerprint(t, b))
+
+	a.RegisterValidator("Call", func(interface{}) error { return nil })
+	assert.NotEqual(t, global, mustFingerprint(t, a))
+}
+
+func TestPlanFingerprint_ChangesWithOptions(t *testing.T) {
+	base := mustFingerprint(t, New())
+	assert.NotEqual(t, base, mustFingerprint(t, NewWithOptions(WithIncludeZeroValues(true))))
+	assert.NotEqual(t, base, mustFingerprint(t, NewWithOptions(WithExcludeFields("Call"))))
+	assert.NotEqual(t, base, mustFingerprint(t, NewWithOptions(WithOnFieldSkipped(func(string, reflect.Type, reflect.Type) {}))))
+}
+
+func TestPlanFingerprint_Errors(t *testing.T) {
+	_, err := New().PlanFingerprint(1, fpDst{})
+	require.Error(t, err)
+	_, err = New().PlanFingerprint(nil, fpDst{})
+	require.Error(t, err)
+}
@@ -0,0 +1,73 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type flDetails struct {
+	Band string
+	Mode string
+}
+
+type flStation struct {
+	Call string
+}
+
+type flNestedQso struct {
+	ID        int64
+	Details   flDetails  `adapter:"flatten"`
+	Contacted *flStation `adapter:"flatten"`
+}
+
+type flFlatQso struct {
+	ID   int64
+	Band string
+	Mode string
+	Call string
+}
+
+func TestFlatten_NamedSubStructIntoFlat(t *testing.T) {
+	d := &flFlatQso{}
+	src := &flNestedQso{ID: 7, Details: flDetails{Band: "20m", Mode: "SSB"}, Contacted: &flStation{Call: "M0CMC"}}
+	require.NoError(t, New().Into(d, src))
+	assert.Equal(t, flFlatQso{ID: 7, Band: "20m", Mode: "SSB", Call: "M0CMC"}, *d)
+}
+
+func TestFlatten_FlatIntoNamedSubStruct(t *testing.T) {
+	d := &flNestedQso{}
+	require.NoError(t, New().Into(d, &flFlatQso{ID: 7, Band: "40m", Mode: "CW", Call: "G4ABC"}))
+	assert.Equal(t, int64(7), d.ID)
+	assert.Equal(t, flDetails{Band: "40m", Mode: "CW"}, d.Details)
+	require.NotNil(t, d.Contacted, "nil flattened pointers are allocated on write")
+	assert.Equal(t, "G4ABC", d.Contacted.Call)
+}
+
+func TestFlatten_NilPointerSourceSkipped(t *testing.T) {
+	d := &flFlatQso{Call: "keep"}
+	require.NoError(t, New().Into(d, &flNestedQso{Details: flDetails{Band: "20m"}}))
+	assert.Equal(t, "20m", d.Band)
+	assert.Equal(t, "keep", d.Call)
+}
+
+func TestFlatten_RequiresTag(t *testing.T) {
+	type S struct {
+		Details flDetails
+	}
+	d := &flFlatQso{}
+	require.NoError(t, New().Into(d, &S{Details: flDetails{Band: "20m"}}))
+	assert.Empty(t, d.Band)
+	r := New().CheckMapping(S{}, flFlatQso{})
+	assert.Contains(t, r.SourceOnly, "Details")
+}
+
+func TestFlatten_NonStructKeptAsField(t *testing.T) {
+	type S struct {
+		Band string `adapter:"flatten"`
+	}
+	d := &flFlatQso{}
+	require.NoError(t, New().Into(d, &S{Band: "20m"}))
+	assert.Equal(t, "20m", d.Band)
+}
@@ -0,0 +1,55 @@
+package adapters
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type flSrc struct {
+	Call string
+	Mode string
+	Band string
+}
+
+type flDst struct {
+	Call string
+	Mode string
+	Band string `json:"band"`
+}
+
+func TestFluent_Chaining(t *testing.T) {
+	upper := func(src interface{}) (interface{}, error) { return strings.ToUpper(src.(string)), nil }
+	a := New().
+		WithConverter("Call", upper).
+		WithConverterFor(flDst{}, "Mode", upper).
+		WithConverterByJSON("band", upper).
+		WithValidator("Call", func(v interface{}) error {
+			if v.(string) == "" {
+				return errors.New("empty call")
+			}
+			return nil
+		})
+	d := flDst{}
+	require.NoError(t, a.Into(&d, &flSrc{Call: "g0abc", Mode: "ssb", Band: "20m"}))
+	assert.Equal(t, flDst{Call: "G0ABC", Mode: "SSB", Band: "20M"}, d)
+	assert.Error(t, a.Into(&flDst{}, &flSrc{}))
+}
+
+func TestFluent_ReturnsSameAdapter(t *testing.T) {
+	a := New()
+	noop := func(src interface{}) (interface{}, error) { return src, nil }
+	okv := func(interface{}) error { return nil }
+	assert.Same(t, a, a.WithConverter("A", noop))
+	assert.Same(t, a, a.WithConverterFor(flDst{}, "A", noop))
+	assert.Same(t, a, a.WithConverterForPair(flSrc{}, flDst{}, "A", noop))
+	assert.Same(t, a, a.WithConverterByJSON("a", noop))
+	assert.Same(t, a, a.WithMarshalTransform("A", func(v interface{}) interface{} { return v }))
+	assert.Same(t, a, a.WithStructConverter(flDst{}, func(dst, src interface{}) error { return nil }))
+	assert.Same(t, a, a.WithValidator("A", okv))
+	assert.Same(t, a, a.WithValidatorFor(flDst{}, "A", okv))
+	assert.Same(t, a, a.WithValidatorForPair(flSrc{}, flDst{}, "A", okv))
+}
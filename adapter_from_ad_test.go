@@ -0,0 +1,58 @@
+package adapters
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fromADSrc struct {
+	Call           string
+	Rig            string
+	AdditionalData null.JSON
+}
+
+type fromADDst struct {
+	Call           string
+	Rig            string
+	Power          int
+	Locked         string `adapter:"readonly"`
+	AdditionalData null.JSON
+}
+
+func TestIntoFromAdditionalData_IgnoresDirectFields(t *testing.T) {
+	src := &fromADSrc{Call: "M0CMC", Rig: "stale", AdditionalData: null.JSONFrom([]byte(`{"Rig":"IC-7300","Power":100,"Locked":"x"}`))}
+	dst := &fromADDst{Call: "G4ABC", Locked: "keep"}
+	require.NoError(t, New().IntoFromAdditionalData(dst, src))
+	assert.Equal(t, "G4ABC", dst.Call, "direct source fields are not copied")
+	assert.Equal(t, "IC-7300", dst.Rig, "AdditionalData wins over the stale same-named field")
+	assert.Equal(t, 100, dst.Power)
+	assert.Equal(t, "keep", dst.Locked)
+	assert.False(t, dst.AdditionalData.Valid, "nothing is marshaled")
+}
+
+func TestIntoFromAdditionalData_ConvertersAndDisableOption(t *testing.T) {
+	a := NewWithOptions(WithDisableUnmarshalAdditionalData(true))
+	a.RegisterConverter("Rig", func(v interface{}) (interface{}, error) { return strings.ToUpper(v.(string)), nil })
+	dst := &fromADDst{}
+	require.NoError(t, a.IntoFromAdditionalData(dst, &fromADSrc{AdditionalData: null.JSONFrom([]byte(`{"Rig":"ic-7300"}`))}))
+	assert.Equal(t, "IC-7300", dst.Rig)
+}
+
+func TestIntoFromAdditionalData_Errors(t *testing.T) {
+	a := New()
+	err := a.IntoFromAdditionalData(&fromADDst{}, &struct{ Call string }{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "has no AdditionalData field")
+
+	err = a.IntoFromAdditionalData(&fromADDst{}, &fromADSrc{AdditionalData: null.JSONFrom([]byte(`{`))})
+	require.Error(t, err)
+	var ae *AdaptError
+	assert.ErrorAs(t, err, &ae)
+
+	require.NoError(t, a.IntoFromAdditionalData(&fromADDst{}, &fromADSrc{}), "invalid AdditionalData is no data")
+	assert.Error(t, a.IntoFromAdditionalData(fromADDst{}, &fromADSrc{}))
+}
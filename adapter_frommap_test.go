@@ -0,0 +1,111 @@
+package adapters
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fmStation struct {
+	Call           string `json:"call"`
+	Power          int    `json:"power"`
+	Port           uint16
+	Freq           float64
+	Enabled        bool
+	Since          time.Time
+	Nick           null.String
+	Secret         string `adapter:"ignore"`
+	ID             int64  `adapter:"readonly"`
+	AdditionalData null.JSON
+}
+
+func TestFromMap_ParsesKinds(t *testing.T) {
+	d := fmStation{}
+	require.NoError(t, New().FromMap(&d, map[string]string{
+		"Call": "M0CMC", "power": "100", "Port": "4532", "Freq": "14.074", "Enabled": "true",
+		"Since": "2025-11-03T14:20:00Z", "Nick": "Marc", "Secret": "x", "ID": "7",
+	}))
+	assert.Equal(t, "M0CMC", d.Call)
+	assert.Equal(t, 100, d.Power)
+	assert.Equal(t, uint16(4532), d.Port)
+	assert.Equal(t, 14.074, d.Freq)
+	assert.True(t, d.Enabled)
+	assert.True(t, time.Date(2025, 11, 3, 14, 20, 0, 0, time.UTC).Equal(d.Since))
+	assert.Equal(t, null.StringFrom("Marc"), d.Nick)
+	assert.Empty(t, d.Secret)
+	assert.Zero(t, d.ID)
+	assert.False(t, d.AdditionalData.Valid, "every key matched a field")
+}
+
+func TestFromMap_UnknownKeysToAdditionalData(t *testing.T) {
+	d := fmStation{}
+	require.NoError(t, New().FromMap(&d, map[string]string{"Call": "M0CMC", "Rig": "IC-7300", "grid": "IO93"}))
+	assert.JSONEq(t, `{"Rig":"IC-7300","grid":"IO93"}`, string(d.AdditionalData.JSON))
+
+	type NoAD struct{ Call string }
+	n := NoAD{}
+	require.NoError(t, New().FromMap(&n, map[string]string{"Call": "M0CMC", "Rig": "x"}))
+	assert.Equal(t, "M0CMC", n.Call)
+
+	err := NewWithOptions(WithErrorOnUnmappedSource(true)).FromMap(&n, map[string]string{"Rig": "x", "Ant": "y"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Ant, Rig")
+}
+
+func TestFromMap_CaseInsensitive(t *testing.T) {
+	d := fmStation{}
+	require.NoError(t, New().FromMap(&d, map[string]string{"CALL": "M0CMC"}))
+	assert.Empty(t, d.Call)
+	assert.JSONEq(t, `{"CALL":"M0CMC"}`, string(d.AdditionalData.JSON))
+
+	d = fmStation{}
+	require.NoError(t, NewWithOptions(WithCaseInsensitiveAdditionalData(true)).FromMap(&d, map[string]string{"CALL": "M0CMC", "POWER": "5"}))
+	assert.Equal(t, "M0CMC", d.Call)
+	assert.Equal(t, 5, d.Power)
+}
+
+func TestFromMap_ConverterReceivesString(t *testing.T) {
+	a := New()
+	a.RegisterConverter("Freq", func(v interface{}) (interface{}, error) {
+		s := v.(string)
+		if !strings.HasSuffix(s, "MHz") {
+			return nil, errors.New("missing unit")
+		}
+		return 14.074, nil
+	})
+	a.RegisterValidator("Power", func(v interface{}) error {
+		if v.(int) > 400 {
+			return errors.New("power too high")
+		}
+		return nil
+	})
+	d := fmStation{}
+	require.NoError(t, a.FromMap(&d, map[string]string{"Freq": "14.074 MHz"}))
+	assert.Equal(t, 14.074, d.Freq)
+
+	err := a.FromMap(&d, map[string]string{"Freq": "14.074"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `adapting field Freq: converter for Freq failed on "14.074"`)
+
+	err = a.FromMap(&d, map[string]string{"Power": "1000"})
+	require.EqualError(t, err, "power too high")
+}
+
+func TestFromMap_Errors(t *testing.T) {
+	a := New()
+	d := fmStation{}
+	for k, v := range map[string]string{"Power": "lots", "Port": "70000", "Enabled": "maybe", "Freq": "x", "Since": "yesterday"} {
+		err := a.FromMap(&d, map[string]string{k: v})
+		require.Error(t, err, k)
+		assert.Contains(t, err.Error(), "adapting field "+k, k)
+	}
+	type Unsupported struct{ Tags []string }
+	require.Error(t, a.FromMap(&Unsupported{}, map[string]string{"Tags": "a,b"}))
+	require.Error(t, a.FromMap(nil, nil))
+	require.Error(t, a.FromMap(fmStation{}, nil))
+}
@@ -0,0 +1,26 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneration_IncrementsOnRegistration(t *testing.T) {
+	a := New()
+	g := a.Generation()
+	assert.Equal(t, uint64(1), g)
+
+	a.RegisterConverter("Call", MapString(func(s string) string { return s }))
+	assert.Equal(t, g+1, a.Generation())
+
+	a.RegisterValidator("Call", func(interface{}) error { return nil })
+	a.RegisterCoalesce("Email", "WorkEmail")
+	assert.Equal(t, g+3, a.Generation())
+
+	a.RegisterFactory(struct{ Call string }{}, func() interface{} { return &struct{ Call string }{} })
+	assert.Equal(t, g+3, a.Generation(), "factories do not affect plans")
+
+	_ = a.Into(&struct{ Call string }{}, &struct{ Call string }{})
+	assert.Equal(t, g+3, a.Generation(), "adapting does not change it")
+}
@@ -0,0 +1,82 @@
+package adapters
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type grpRow struct {
+	Call           string
+	Band           string
+	Mode           string
+	Freq           int64
+	Rig            string
+	AdditionalData null.JSON
+}
+
+type grpDetails struct {
+	Band string
+	Mode string
+	Freq string
+}
+
+type grpQso struct {
+	Call           string
+	Details        grpDetails
+	Extra          *grpDetails
+	AdditionalData null.JSON
+}
+
+func TestFieldGroup_GroupsFlatFields(t *testing.T) {
+	a := New().WithFieldGroup(grpQso{}, "Details", "Band", "Mode")
+	d := grpQso{}
+	require.NoError(t, a.Into(&d, &grpRow{Call: "M0CMC", Band: "20m", Mode: "SSB", Rig: "IC-7300"}))
+	assert.Equal(t, "M0CMC", d.Call)
+	assert.Equal(t, grpDetails{Band: "20m", Mode: "SSB"}, d.Details)
+	assert.Nil(t, d.Extra)
+	// grouped fields are consumed; zero Freq is omitted, so only Rig remains for AdditionalData
+	assert.JSONEq(t, `{"Rig":"IC-7300"}`, string(d.AdditionalData.JSON))
+}
+
+func TestFieldGroup_PointerGroupAllocatedAndConverterScopedToGroupType(t *testing.T) {
+	a := New()
+	a.RegisterFieldGroup(&grpQso{}, "Extra", "Band", "Freq")
+	a.RegisterConverterFor(grpDetails{}, "Freq", func(v interface{}) (interface{}, error) {
+		return "14.074", nil
+	})
+	d := grpQso{}
+	require.NoError(t, a.Into(&d, &grpRow{Band: "20m", Freq: 14074000}))
+	require.NotNil(t, d.Extra)
+	assert.Equal(t, grpDetails{Band: "20m", Freq: "14.074"}, *d.Extra)
+	assert.Equal(t, grpDetails{}, d.Details)
+}
+
+func TestFieldGroup_ErrorNamesGroupMember(t *testing.T) {
+	a := New().WithFieldGroup(grpQso{}, "Extra", "Freq")
+	a.RegisterConverterFor(grpDetails{}, "Freq", func(v interface{}) (interface{}, error) {
+		return nil, errors.New("bad freq")
+	})
+	err := a.Into(&grpQso{}, &grpRow{Freq: 1})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "adapting field Extra.Freq")
+}
+
+func TestFieldGroup_UnknownFieldsIgnored(t *testing.T) {
+	a := New().WithFieldGroup(grpQso{}, "Details", "Band", "Nope").WithFieldGroup(grpQso{}, "Call", "Band").WithFieldGroup(grpQso{}, "Missing", "Band")
+	d := grpQso{}
+	require.NoError(t, a.Into(&d, &grpRow{Call: "G0ABC", Band: "40m"}))
+	assert.Equal(t, "G0ABC", d.Call)
+	assert.Equal(t, "40m", d.Details.Band)
+}
+
+func TestFieldGroup_OtherDestinationsUnaffected(t *testing.T) {
+	a := New().WithFieldGroup(grpQso{}, "Details", "Band")
+	type Flat struct{ Band string }
+	d := Flat{}
+	require.NoError(t, a.Into(&d, &grpRow{Band: "20m"}))
+	assert.Equal(t, "20m", d.Band)
+}
@@ -0,0 +1,72 @@
+package adapters
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ipQso struct {
+	Call           string
+	Band           string
+	Mode           string
+	AdditionalData null.JSON
+}
+
+func TestInPlace_ConverterNormalizes(t *testing.T) {
+	a := New()
+	a.RegisterConverter("Call", func(v interface{}) (interface{}, error) { return strings.ToUpper(v.(string)), nil })
+	q := ipQso{Call: "g0abc", Band: "20m", AdditionalData: null.JSONFrom([]byte(`{"rig":"IC-7300"}`))}
+	require.NoError(t, a.Into(&q, &q))
+	assert.Equal(t, "G0ABC", q.Call)
+	assert.Equal(t, "20m", q.Band)
+	assert.JSONEq(t, `{"rig":"IC-7300"}`, string(q.AdditionalData.JSON), "AdditionalData is left untouched")
+}
+
+func TestInPlace_ConvertersSeeOriginalValues(t *testing.T) {
+	a := New()
+	// src handed to converters is the pre-call snapshot, even after dst fields were rewritten
+	a.RegisterConverter("Band", func(v interface{}) (interface{}, error) { return "rewritten-" + v.(string), nil })
+	var seen []string
+	a.RegisterConverter("Mode", func(v interface{}) (interface{}, error) {
+		seen = append(seen, v.(string))
+		return v, nil
+	})
+	a.RegisterStructConverter(ipQso{}, func(dst, src interface{}) error {
+		seen = append(seen, src.(*ipQso).Band, dst.(*ipQso).Band)
+		return nil
+	})
+	q := ipQso{Band: "20m", Mode: "SSB"}
+	require.NoError(t, a.Into(&q, &q))
+	assert.Equal(t, "rewritten-20m", q.Band)
+	assert.Equal(t, []string{"SSB", "20m", "rewritten-20m"}, seen)
+}
+
+func TestInPlace_ValidatorErrorStopsPass(t *testing.T) {
+	a := New()
+	a.RegisterConverter("Call", func(v interface{}) (interface{}, error) { return strings.TrimSpace(v.(string)), nil })
+	a.RegisterValidator("Call", func(v interface{}) error {
+		if v.(string) == "" {
+			return errors.New("call required")
+		}
+		return nil
+	})
+	q := ipQso{Call: "  "}
+	assert.Error(t, a.Into(&q, &q))
+
+	q = ipQso{Call: " G0ABC "}
+	require.NoError(t, a.Into(&q, &q))
+	assert.Equal(t, "G0ABC", q.Call)
+}
+
+func TestInPlace_NoConvertersIsNoop(t *testing.T) {
+	a := New()
+	q := ipQso{Call: "x", Band: "40m", AdditionalData: null.JSONFrom([]byte(`{"a":1}`))}
+	before := q
+	require.NoError(t, a.Into(&q, &q))
+	assert.Equal(t, before, q)
+}
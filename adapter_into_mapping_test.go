@@ -0,0 +1,79 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mappingSrc struct {
+	Callsign       string
+	Call           string
+	TxFreq         string
+	Mode           string
+	AdditionalData null.JSON
+}
+
+type mappingDst struct {
+	Call           string
+	Freq           string
+	Mode           string
+	AdditionalData null.JSON
+}
+
+func TestIntoWithMapping_Partial(t *testing.T) {
+	a := New()
+	dst := &mappingDst{}
+	src := &mappingSrc{Callsign: "M0CMC", Call: "G4ABC", TxFreq: "14.320", Mode: "SSB"}
+	require.NoError(t, a.IntoWithMapping(dst, src, map[string]string{"Call": "Callsign", "Freq": "TxFreq"}))
+	assert.Equal(t, "M0CMC", dst.Call)
+	assert.Equal(t, "14.320", dst.Freq)
+	assert.Equal(t, "SSB", dst.Mode, "unlisted fields fall back to name matching")
+	assert.JSONEq(t, `{"Call":"G4ABC"}`, string(dst.AdditionalData.JSON), "the displaced name match is unmatched")
+}
+
+func TestIntoWithMapping_NotPersistent(t *testing.T) {
+	a := New()
+	src := &mappingSrc{Callsign: "M0CMC", Call: "G4ABC"}
+	require.NoError(t, a.IntoWithMapping(&mappingDst{}, src, map[string]string{"Call": "Callsign"}))
+	dst := &mappingDst{}
+	require.NoError(t, a.Into(dst, src))
+	assert.Equal(t, "G4ABC", dst.Call)
+}
+
+func TestIntoWithMapping_ConverterByDestinationName(t *testing.T) {
+	a := New()
+	a.RegisterConverter("Freq", func(v interface{}) (interface{}, error) { return v.(string) + " MHz", nil })
+	dst := &mappingDst{}
+	require.NoError(t, a.IntoWithMapping(dst, &mappingSrc{TxFreq: "7.074"}, map[string]string{"Freq": "TxFreq"}))
+	assert.Equal(t, "7.074 MHz", dst.Freq)
+}
+
+func TestIntoWithMapping_InvalidEntry(t *testing.T) {
+	a := New()
+	dst := &mappingDst{Call: "unchanged"}
+	src := &mappingSrc{Callsign: "M0CMC"}
+	err := a.IntoWithMapping(dst, src, map[string]string{"Call": "Callsign", "Band": "TxFreq"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no field Band in adapters.mappingDst")
+	assert.Equal(t, "unchanged", dst.Call, "nothing is written for an invalid mapping")
+
+	err = a.IntoWithMapping(dst, src, map[string]string{"Call": "Sign"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no field Sign in adapters.mappingSrc")
+
+	err = a.IntoWithMapping(dst, src, map[string]string{"AdditionalData": "Callsign"})
+	require.Error(t, err)
+}
+
+func TestIntoWithMapping_StrictUnmapped(t *testing.T) {
+	a := NewStrict()
+	type src struct{ Callsign, Mode string }
+	type dst struct{ Call, Mode string }
+	require.Error(t, a.Into(&dst{}, &src{}))
+	d := &dst{}
+	require.NoError(t, a.IntoWithMapping(d, &src{Callsign: "M0CMC", Mode: "CW"}, map[string]string{"Call": "Callsign"}))
+	assert.Equal(t, dst{Call: "M0CMC", Mode: "CW"}, *d)
+}
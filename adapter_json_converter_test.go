@@ -0,0 +1,64 @@
+package adapters
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type jcSrc struct {
+	Call string `json:"call"`
+}
+
+type jcDst struct {
+	Callsign string `json:"call"`
+}
+
+func TestConverterByJSON_MatchViaJSONName(t *testing.T) {
+	a := New()
+	a.RegisterConverterByJSON("call", MapString(strings.ToUpper))
+	s := jcSrc{Call: "g0abc"}
+	d := jcDst{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, "G0ABC", d.Callsign)
+}
+
+func TestConverterByJSON_GoNameWins(t *testing.T) {
+	a := New()
+	a.RegisterConverterByJSON("call", MapString(strings.ToUpper))
+	a.RegisterConverter("Callsign", MapString(func(s string) string { return s + "/P" }))
+	s := jcSrc{Call: "g0abc"}
+	d := jcDst{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, "g0abc/P", d.Callsign)
+}
+
+func TestConverterByJSON_AdditionalData(t *testing.T) {
+	a := New()
+	a.RegisterConverterByJSON("call", MapString(strings.ToUpper))
+	type S struct{ AdditionalData null.JSON }
+	b, _ := json.Marshal(map[string]any{"call": "m0xyz"})
+	s := S{AdditionalData: null.JSONFrom(b)}
+	d := jcDst{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, "M0XYZ", d.Callsign)
+}
+
+func TestConverterByJSON_BuilderAndBatch(t *testing.T) {
+	a := NewBuilder().AddConverterByJSON("call", MapString(strings.ToUpper)).Build()
+	s := jcSrc{Call: "g0abc"}
+	d := jcDst{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, "G0ABC", d.Callsign)
+
+	a2 := New()
+	a2.Batch(func(r *RegistryBatch) { r.ConverterByJSON("call", MapString(strings.ToLower)) })
+	s2 := jcSrc{Call: "G0ABC"}
+	d2 := jcDst{}
+	require.NoError(t, a2.Into(&d2, &s2))
+	assert.Equal(t, "g0abc", d2.Callsign)
+}
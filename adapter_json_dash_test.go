@@ -0,0 +1,66 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type dashSrc struct {
+	Call           string
+	Token          string `json:"-"`
+	Dash           string `json:"-,"`
+	Rig            string
+	AdditionalData null.JSON
+}
+
+type dashDst struct {
+	Call           string
+	Token          string `json:"-"`
+	AdditionalData null.JSON
+}
+
+type dashNoToken struct {
+	Call           string
+	AdditionalData null.JSON
+}
+
+func TestRespectJSONDash_NotMarshaled(t *testing.T) {
+	src := &dashSrc{Call: "M0CMC", Token: "secret", Dash: "d", Rig: "IC-7300"}
+
+	d := dashNoToken{}
+	require.NoError(t, New().Into(&d, src))
+	assert.JSONEq(t, `{"Token":"secret","Dash":"d","Rig":"IC-7300"}`, string(d.AdditionalData.JSON))
+
+	d = dashNoToken{}
+	require.NoError(t, NewWithOptions(WithRespectJSONDash(true)).Into(&d, src))
+	// json:"-," names the key "-" in encoding/json, so it is not a dash field
+	assert.JSONEq(t, `{"Dash":"d","Rig":"IC-7300"}`, string(d.AdditionalData.JSON))
+}
+
+func TestRespectJSONDash_DirectCopyUnaffected(t *testing.T) {
+	d := dashDst{}
+	require.NoError(t, NewWithOptions(WithRespectJSONDash(true)).Into(&d, &dashSrc{Call: "M0CMC", Token: "secret"}))
+	assert.Equal(t, "secret", d.Token)
+}
+
+func TestRespectJSONDash_NotFilledFromAdditionalData(t *testing.T) {
+	src := &dashNoToken{Call: "M0CMC", AdditionalData: null.JSONFrom([]byte(`{"Token":"secret"}`))}
+
+	d := dashDst{}
+	require.NoError(t, New().Into(&d, src))
+	assert.Equal(t, "secret", d.Token)
+
+	d = dashDst{}
+	require.NoError(t, NewWithOptions(WithRespectJSONDash(true)).Into(&d, src))
+	assert.Empty(t, d.Token)
+}
+
+func TestRespectJSONDash_MappingReport(t *testing.T) {
+	r := NewWithOptions(WithRespectJSONDash(true)).CheckMapping(dashSrc{}, dashNoToken{})
+	assert.Contains(t, r.SourceOnly, "Token")
+	assert.NotContains(t, r.ToAdditionalData, "Token")
+	assert.Contains(t, r.ToAdditionalData, "Rig")
+}
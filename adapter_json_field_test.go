@@ -0,0 +1,90 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type jfDetails struct {
+	Band string `json:"band"`
+	Mode string `json:"mode"`
+}
+
+type jfType struct {
+	Details *jfDetails
+	Tags    []string
+	Extra   map[string]int
+	Station jfDetails
+}
+
+type jfModel struct {
+	Details string
+	Tags    []byte
+	Extra   string
+	Station []byte
+}
+
+func TestStructToJSONField_Encode(t *testing.T) {
+	a := NewWithOptions(WithStructToJSONField(true))
+	m := jfModel{}
+	require.NoError(t, a.Into(&m, &jfType{
+		Details: &jfDetails{Band: "20m", Mode: "FT8"},
+		Tags:    []string{"dx", "pota"},
+		Extra:   map[string]int{"sfi": 150},
+		Station: jfDetails{Band: "40m"},
+	}))
+	assert.JSONEq(t, `{"band":"20m","mode":"FT8"}`, m.Details)
+	assert.JSONEq(t, `["dx","pota"]`, string(m.Tags))
+	assert.JSONEq(t, `{"sfi":150}`, m.Extra)
+	assert.JSONEq(t, `{"band":"40m","mode":""}`, string(m.Station))
+
+	m = jfModel{Details: "old", Tags: []byte("old")}
+	require.NoError(t, a.Into(&m, &jfType{}))
+	assert.Empty(t, m.Details, "nil pointer source clears the text")
+	assert.Nil(t, m.Tags)
+}
+
+func TestStructToJSONField_Decode(t *testing.T) {
+	a := NewWithOptions(WithStructToJSONField(true))
+	d := jfType{Details: &jfDetails{Band: "old"}}
+	require.NoError(t, a.Into(&d, &jfModel{
+		Details: `{"band":"20m","mode":"FT8"}`,
+		Tags:    []byte(`["dx"]`),
+		Extra:   `{"sfi":150}`,
+	}))
+	assert.Equal(t, &jfDetails{Band: "20m", Mode: "FT8"}, d.Details)
+	assert.Equal(t, []string{"dx"}, d.Tags)
+	assert.Equal(t, map[string]int{"sfi": 150}, d.Extra)
+	assert.Equal(t, jfDetails{}, d.Station, "empty text sets the zero value")
+}
+
+func TestStructToJSONField_MalformedJSON(t *testing.T) {
+	a := NewWithOptions(WithStructToJSONField(true))
+	err := a.Into(&jfType{}, &jfModel{Details: `{"band":`})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "adapting field Details: decoding JSON")
+
+	err = a.Into(&jfType{}, &jfModel{Tags: []byte(`{"not":"a list"}`)})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "adapting field Tags")
+}
+
+func TestStructToJSONField_OffByDefault(t *testing.T) {
+	m := jfModel{}
+	require.NoError(t, New().Into(&m, &jfType{Details: &jfDetails{Band: "20m"}, Tags: []string{"dx"}}))
+	assert.Empty(t, m.Details)
+	assert.Nil(t, m.Tags)
+
+	r := NewWithOptions(WithStructToJSONField(true)).CheckMapping(jfType{}, jfModel{})
+	assert.ElementsMatch(t, []string{"Details", "Tags", "Extra", "Station"}, r.Matched)
+}
+
+func TestStructToJSONField_ConverterWins(t *testing.T) {
+	a := NewWithOptions(WithStructToJSONField(true))
+	a.RegisterConverter("Details", func(v interface{}) (interface{}, error) { return "custom", nil })
+	m := jfModel{}
+	require.NoError(t, a.Into(&m, &jfType{Details: &jfDetails{Band: "20m"}}))
+	assert.Equal(t, "custom", m.Details)
+}
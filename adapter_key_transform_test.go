@@ -0,0 +1,77 @@
+package adapters
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type keyTransformWide struct {
+	Call          string
+	TxPwr         string
+	ContactedOpID int64
+	MyGridsquare  string
+}
+
+type keyTransformNarrow struct {
+	Call           string
+	AdditionalData null.JSON
+}
+
+func TestKeyTransform_Builtins(t *testing.T) {
+	for _, name := range []string{"TxPwr", "MyGridsquare", "Call", "ContactedOpID"} {
+		assert.True(t, strings.EqualFold(name, FromSnakeCaseKey(SnakeCaseKey(name))), name)
+		assert.True(t, strings.EqualFold(name, FromKebabCaseKey(KebabCaseKey(name))), name)
+	}
+	assert.Equal(t, "contacted_op_id", SnakeCaseKey("ContactedOpID"))
+	assert.Equal(t, "contacted-op-id", KebabCaseKey("ContactedOpID"))
+	assert.Equal(t, "TxPwr", FromSnakeCaseKey("tx_pwr"))
+	assert.Equal(t, "TxPwr", FromKebabCaseKey("tx-pwr"))
+}
+
+func TestKeyTransform_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name           string
+		encode, decode func(string) string
+		want           string
+	}{
+		{"snake_case", SnakeCaseKey, FromSnakeCaseKey, `{"tx_pwr":"100","contacted_op_id":7,"my_gridsquare":"IO91"}`},
+		{"kebab-case", KebabCaseKey, FromKebabCaseKey, `{"tx-pwr":"100","contacted-op-id":7,"my-gridsquare":"IO91"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := NewWithOptions(WithAdditionalDataKeyTransform(tt.encode, tt.decode))
+			src := keyTransformWide{Call: "M0CMC", TxPwr: "100", ContactedOpID: 7, MyGridsquare: "IO91"}
+			narrow := &keyTransformNarrow{}
+			require.NoError(t, a.Into(narrow, &src))
+			assert.Equal(t, "M0CMC", narrow.Call)
+			assert.JSONEq(t, tt.want, string(narrow.AdditionalData.JSON))
+
+			back := &keyTransformWide{}
+			require.NoError(t, a.Into(back, narrow))
+			assert.Equal(t, src, *back, "decode inverts encode, including the ID acronym")
+		})
+	}
+}
+
+func TestKeyTransform_OneDirection(t *testing.T) {
+	a := NewWithOptions(WithAdditionalDataKeyTransform(KebabCaseKey, nil))
+	narrow := &keyTransformNarrow{}
+	require.NoError(t, a.Into(narrow, &keyTransformWide{TxPwr: "5"}))
+	assert.JSONEq(t, `{"tx-pwr":"5"}`, string(narrow.AdditionalData.JSON))
+
+	back := &keyTransformWide{}
+	require.NoError(t, a.Into(back, &keyTransformNarrow{AdditionalData: null.JSONFrom([]byte(`{"TxPwr":"10","tx-pwr":"5"}`))}))
+	assert.Equal(t, "10", back.TxPwr, "keys are matched verbatim without a decoder")
+}
+
+func TestKeyTransform_WithLowercaseKeys(t *testing.T) {
+	upper := func(s string) string { return strings.ToUpper(s) }
+	a := NewWithOptions(WithAdditionalDataKeyTransform(upper, nil), WithLowercaseAdditionalDataKeys(true))
+	narrow := &keyTransformNarrow{}
+	require.NoError(t, a.Into(narrow, &keyTransformWide{TxPwr: "5"}))
+	assert.JSONEq(t, `{"txpwr":"5"}`, string(narrow.AdditionalData.JSON), "lowercasing runs after encode")
+}
@@ -0,0 +1,100 @@
+package adapters
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	boilertypes "github.com/aarondl/sqlboiler/v4/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type lintInner struct {
+	Rig   string
+	Notes string `adapter:"ignor"`
+}
+
+type lintGood struct {
+	lintInner      `adapter:"prefix=My"`
+	Details        lintInner `adapter:"flatten"`
+	Call           string
+	Password       string    `adapter:"ignore"`
+	Secret         string    `adapter:"-"`
+	ID             int64     `adapter:"readonly"`
+	Hash           string    `adapter:"writeonly"`
+	QslSent        string    `adapter:"adpath=qsl.sent"`
+	AdditionalData null.JSON `adapter:"additional,omitempty"`
+}
+
+func errStrings(errs []error) string {
+	var b strings.Builder
+	for _, e := range errs {
+		b.WriteString(e.Error())
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+func TestCheckStruct_SupportedTags(t *testing.T) {
+	type Good struct {
+		Call     string
+		Password string               `adapter:"ignore"`
+		Secret   string               `adapter:"-"`
+		ID       int64                `adapter:"readonly"`
+		Hash     string               `adapter:"writeonly"`
+		QslSent  string               `adapter:"adpath=qsl.sent"`
+		Inner    struct{ Rig string } `adapter:"flatten"`
+		Extra    boilertypes.JSON     `adapter:"additional"`
+	}
+	assert.Empty(t, CheckStruct(reflect.TypeOf(Good{})))
+	assert.Empty(t, CheckStruct(reflect.TypeOf(&Good{})))
+}
+
+func TestCheckStruct_UnknownTagsInEmbedded(t *testing.T) {
+	errs := CheckStruct(reflect.TypeOf(lintGood{}))
+	require.Len(t, errs, 2, errStrings(errs))
+	out := errStrings(errs)
+	assert.Contains(t, out, `field lintInner.Notes: unknown adapter tag "ignor"`)
+	assert.Contains(t, out, `field Details.Notes: unknown adapter tag "ignor"`)
+}
+
+func TestCheckStruct_Misconfigurations(t *testing.T) {
+	type Bad struct {
+		A              string    `adapter:"aditional"`
+		B              string    `adapter:"additional"`
+		C              null.JSON `adapter:"additional,omitmepty"`
+		D              string    `adapter:"flatten"`
+		E              string    `adapter:"prefix=X"`
+		F              string    `adapter:"adpath="`
+		G              string    `adapter:"adpath=a..b"`
+		AdditionalData null.JSON
+	}
+	out := errStrings(CheckStruct(reflect.TypeOf(Bad{})))
+	for _, want := range []string{
+		`field A: unknown adapter tag "aditional"`,
+		`field B: adapter:"additional" needs null.JSON or types.JSON (or a pointer to one), got string`,
+		`field C: unknown adapter tag "additional,omitmepty"`,
+		`field D: adapter:"flatten" needs a struct`,
+		`field E: adapter:"prefix=..." only applies`,
+		`field F: invalid adapter path ""`,
+		`field G: invalid adapter path "a..b"`,
+		`multiple AdditionalData fields: C, AdditionalData`,
+	} {
+		assert.Contains(t, out, want)
+	}
+}
+
+func TestCheckStruct_NotStruct(t *testing.T) {
+	require.Len(t, CheckStruct(reflect.TypeOf(1)), 1)
+	require.Len(t, CheckStruct(nil), 1)
+}
+
+func TestCheckStruct_SelfReferentialEmbedding(t *testing.T) {
+	type Node struct {
+		*Node
+		Name string `adapter:"readonly"`
+	}
+	assert.Empty(t, CheckStruct(reflect.TypeOf(Node{})))
+}
@@ -0,0 +1,45 @@
+package adapters
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type lcSrc struct {
+	Call      string
+	RigName   string
+	TXPower   int
+	AntHeight int `json:"ant_height"`
+}
+
+type lcDst struct {
+	Call           string
+	AdditionalData null.JSON
+}
+
+func TestLowercaseAdditionalDataKeys(t *testing.T) {
+	a := NewWithOptions(WithLowercaseAdditionalDataKeys(true))
+	d := &lcDst{}
+	require.NoError(t, a.Into(d, &lcSrc{Call: "M0CMC", RigName: "IC-7300", TXPower: 100, AntHeight: 12}))
+	var keys map[string]interface{}
+	require.NoError(t, json.Unmarshal(d.AdditionalData.JSON, &keys))
+	assert.Equal(t, map[string]interface{}{"rigname": "IC-7300", "txpower": float64(100), "antheight": float64(12)}, keys)
+}
+
+func TestLowercaseAdditionalDataKeys_RoundTripCaseInsensitive(t *testing.T) {
+	src := &lcSrc{Call: "M0CMC", RigName: "IC-7300", TXPower: 100, AntHeight: 12}
+	d := &lcDst{}
+	require.NoError(t, NewWithOptions(WithLowercaseAdditionalDataKeys(true)).Into(d, src))
+
+	back := &lcSrc{}
+	require.NoError(t, NewWithOptions(WithCaseInsensitiveAdditionalData(true)).Into(back, d))
+	assert.Equal(t, src, back)
+
+	exact := &lcSrc{}
+	require.NoError(t, New().Into(exact, d))
+	assert.Empty(t, exact.RigName, "lowercased keys need case-insensitive matching")
+}
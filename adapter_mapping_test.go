@@ -0,0 +1,77 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+)
+
+type cmSrc struct {
+	Call     string
+	Freq     int64
+	Grid     string
+	Secret   string `adapter:"ignore"`
+	Comments []string
+}
+
+type cmDst struct {
+	Call     string
+	Freq     string
+	Band     string
+	Comments int
+}
+
+func TestCheckMapping_Report(t *testing.T) {
+	a := New()
+	a.RegisterConverter("Freq", func(v any) (any, error) { return "", nil })
+	r := a.CheckMapping(cmSrc{}, &cmDst{})
+	assert.NoError(t, r.Err)
+	assert.Equal(t, []string{"Call", "Freq"}, r.Matched)
+	assert.Equal(t, []string{"Comments"}, r.Incompatible)
+	assert.Equal(t, []string{"Grid"}, r.SourceOnly)
+	assert.Empty(t, r.ToAdditionalData)
+	assert.Equal(t, []string{"Band"}, r.DestinationOnly)
+	assert.False(t, r.Clean())
+}
+
+func TestCheckMapping_AdditionalDataSink(t *testing.T) {
+	a := New()
+	type D struct {
+		Call           string
+		AdditionalData null.JSON
+	}
+	type S struct {
+		Call string
+		Grid string
+	}
+	r := a.CheckMapping(S{}, D{})
+	assert.Equal(t, []string{"Grid"}, r.ToAdditionalData)
+	assert.Empty(t, r.SourceOnly)
+	assert.True(t, r.Clean())
+
+	a2 := NewWithOptions(WithDisableMarshalAdditionalData(true))
+	r2 := a2.CheckMapping(S{}, D{})
+	assert.Equal(t, []string{"Grid"}, r2.SourceOnly)
+}
+
+func TestCheckMapping_CleanIdentical(t *testing.T) {
+	a := New()
+	r := a.CheckMapping(cmDst{}, cmDst{})
+	assert.True(t, r.Clean())
+	assert.Len(t, r.Matched, 4)
+}
+
+func TestCheckMapping_NullAware(t *testing.T) {
+	type M struct{ Name null.String }
+	type T struct{ Name string }
+	assert.Equal(t, []string{"Name"}, New().CheckMapping(M{}, T{}).Incompatible)
+	assert.True(t, NewWithOptions(WithNullAware(true)).CheckMapping(M{}, T{}).Clean())
+}
+
+func TestCheckMapping_Errors(t *testing.T) {
+	a := New()
+	assert.Error(t, a.CheckMapping(nil, cmDst{}).Err)
+	assert.Error(t, a.CheckMapping(1, cmDst{}).Err)
+	assert.False(t, a.CheckMapping(1, cmDst{}).Clean())
+}
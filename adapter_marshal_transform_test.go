@@ -0,0 +1,45 @@
+package adapters
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalTransform_RedactsAdditionalData(t *testing.T) {
+	a := New()
+	a.RegisterMarshalTransform("Email", func(v interface{}) interface{} { return "***" })
+	type S struct {
+		Name  string
+		Email string
+	}
+	type D struct {
+		Name           string
+		AdditionalData null.JSON
+	}
+	s := S{Name: "n", Email: "a@b.c"}
+	d := D{}
+	require.NoError(t, a.Into(&d, &s))
+	var m map[string]any
+	require.NoError(t, json.Unmarshal(d.AdditionalData.JSON, &m))
+	assert.Equal(t, "***", m["Email"])
+	assert.Equal(t, "a@b.c", s.Email)
+}
+
+func TestMarshalTransform_NotAppliedToMatchedFields(t *testing.T) {
+	a := New()
+	a.RegisterMarshalTransform("Email", func(v interface{}) interface{} { return "***" })
+	type S struct{ Email string }
+	type D struct {
+		Email          string
+		AdditionalData null.JSON
+	}
+	s := S{Email: "a@b.c"}
+	d := D{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, "a@b.c", d.Email)
+	assert.False(t, d.AdditionalData.Valid)
+}
@@ -0,0 +1,120 @@
+package adapters
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func caseInsensitiveMatcher(dst FieldInfo, src SourceFields) (FieldInfo, bool) {
+	if sf, ok := DefaultMatcher(dst, src); ok {
+		return sf, true
+	}
+	for _, sf := range src.All() {
+		if strings.EqualFold(sf.Name, dst.Name) {
+			return sf, true
+		}
+	}
+	return FieldInfo{}, false
+}
+
+func TestFieldMatcher_CaseInsensitive(t *testing.T) {
+	type S struct {
+		CallSign string
+		Band     string
+	}
+	type D struct {
+		Callsign string
+		Band     string
+	}
+	src := &S{CallSign: "M0CMC", Band: "20m"}
+
+	dst := &D{}
+	require.NoError(t, New().Into(dst, src))
+	assert.Empty(t, dst.Callsign, "default matching is exact")
+
+	dst = &D{}
+	require.NoError(t, NewWithOptions(WithFieldMatcher(caseInsensitiveMatcher)).Into(dst, src))
+	assert.Equal(t, D{Callsign: "M0CMC", Band: "20m"}, *dst)
+}
+
+func TestFieldMatcher_JSONFirst(t *testing.T) {
+	type S struct {
+		Name  string
+		Other string `json:"name"`
+	}
+	type D struct {
+		Name string `json:"name"`
+	}
+	jsonFirst := func(dst FieldInfo, src SourceFields) (FieldInfo, bool) {
+		if dst.JSONName != "" {
+			if sf, ok := src.ByJSONName(dst.JSONName); ok {
+				return sf, true
+			}
+		}
+		return src.ByName(dst.Name)
+	}
+	dst := &D{}
+	require.NoError(t, NewWithOptions(WithFieldMatcher(jsonFirst)).Into(dst, &S{Name: "by-name", Other: "by-json"}))
+	assert.Equal(t, "by-json", dst.Name)
+}
+
+func TestFieldMatcher_SeesTags(t *testing.T) {
+	type S struct {
+		Legacy string `alias:"Call"`
+	}
+	type D struct {
+		Call string
+	}
+	byAlias := func(dst FieldInfo, src SourceFields) (FieldInfo, bool) {
+		for _, sf := range src.All() {
+			if sf.Tag.Get("alias") == dst.Name {
+				return sf, true
+			}
+		}
+		return DefaultMatcher(dst, src)
+	}
+	a := NewWithOptions(WithFieldMatcher(byAlias))
+	dst := &D{}
+	require.NoError(t, a.Into(dst, &S{Legacy: "G4ABC"}))
+	assert.Equal(t, "G4ABC", dst.Call)
+	assert.True(t, a.CheckMapping(S{}, D{}).Clean(), "CheckMapping uses the same matcher")
+}
+
+func TestFieldMatcher_UnknownOrIgnoredResultIsNoMatch(t *testing.T) {
+	type S struct {
+		A string
+		B string `adapter:"ignore"`
+	}
+	type D struct {
+		A string
+		C string
+	}
+	m := func(dst FieldInfo, src SourceFields) (FieldInfo, bool) {
+		if dst.Name == "A" {
+			return FieldInfo{Name: "Missing"}, true
+		}
+		return src.ByName("B")
+	}
+	dst := &D{}
+	require.NoError(t, NewWithOptions(WithFieldMatcher(m)).Into(dst, &S{A: "a", B: "b"}))
+	assert.Equal(t, D{}, *dst)
+}
+
+func TestDefaultMatcher_SameAsBuiltIn(t *testing.T) {
+	type S struct {
+		Name string
+		Mail string `json:"email"`
+	}
+	type D struct {
+		Name  string
+		Email string `json:"email"`
+	}
+	src := &S{Name: "Test", Mail: "a@b.c"}
+	want, got := &D{}, &D{}
+	require.NoError(t, New().Into(want, src))
+	require.NoError(t, NewWithOptions(WithFieldMatcher(DefaultMatcher)).Into(got, src))
+	assert.Equal(t, want, got)
+}
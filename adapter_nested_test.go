@@ -0,0 +1,181 @@
+package adapters
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type nestedTypeAddress struct {
+	Street string
+	City   string
+}
+
+type nestedModelAddress struct {
+	Street   string
+	City     string
+	Verified bool
+}
+
+type nestedTypeContact struct {
+	Call    string
+	Address *nestedTypeAddress
+	Home    nestedTypeAddress
+}
+
+type nestedModelContact struct {
+	Call    string
+	Address *nestedModelAddress
+	Home    *nestedModelAddress
+}
+
+func TestNestedStructs_DisabledByDefault(t *testing.T) {
+	d := &nestedModelContact{}
+	require.NoError(t, New().Into(d, &nestedTypeContact{Call: "M0CMC", Address: &nestedTypeAddress{City: "Leeds"}}))
+	assert.Equal(t, "M0CMC", d.Call)
+	assert.Nil(t, d.Address)
+}
+
+func TestNestedStructs_AllocatesNamedPointers(t *testing.T) {
+	a := NewWithOptions(WithNestedStructs(true))
+	src := &nestedTypeContact{
+		Call:    "M0CMC",
+		Address: &nestedTypeAddress{Street: "1 High St", City: "Leeds"},
+		Home:    nestedTypeAddress{City: "York"},
+	}
+	d := &nestedModelContact{}
+	require.NoError(t, a.Into(d, src))
+	require.NotNil(t, d.Address)
+	require.NotNil(t, d.Home)
+	assert.Equal(t, nestedModelAddress{Street: "1 High St", City: "Leeds"}, *d.Address)
+	assert.Equal(t, nestedModelAddress{City: "York"}, *d.Home)
+}
+
+func TestNestedStructs_AbsentOrZeroSourceSetsNil(t *testing.T) {
+	a := NewWithOptions(WithNestedStructs(true))
+	d := &nestedModelContact{Address: &nestedModelAddress{City: "old"}, Home: &nestedModelAddress{City: "old"}}
+	require.NoError(t, a.Into(d, &nestedTypeContact{Address: &nestedTypeAddress{}}))
+	assert.Nil(t, d.Address, "pointer to an all-zero struct counts as absent")
+	assert.Nil(t, d.Home, "zero struct counts as absent")
+}
+
+func TestNestedStructs_ModelToType(t *testing.T) {
+	a := NewWithOptions(WithNestedStructs(true))
+	src := &nestedModelContact{Call: "G4ABC", Address: &nestedModelAddress{City: "Leeds", Verified: true}}
+	d := &nestedTypeContact{Home: nestedTypeAddress{City: "old"}}
+	require.NoError(t, a.Into(d, src))
+	assert.Equal(t, nestedTypeContact{Call: "G4ABC", Address: &nestedTypeAddress{City: "Leeds"}}, *d)
+}
+
+func TestNestedStructs_ExistingPointerNotMutated(t *testing.T) {
+	a := NewWithOptions(WithNestedStructs(true))
+	shared := &nestedModelAddress{Street: "keep", Verified: true}
+	d := &nestedModelContact{Address: shared}
+	require.NoError(t, a.Into(d, &nestedTypeContact{Address: &nestedTypeAddress{City: "Leeds"}}))
+	assert.Equal(t, nestedModelAddress{Street: "", City: "Leeds", Verified: true}, *d.Address, "unmapped fields survive")
+	assert.NotSame(t, shared, d.Address)
+	assert.Equal(t, nestedModelAddress{Street: "keep", Verified: true}, *shared)
+}
+
+func TestNestedStructs_ErrorsNameTheField(t *testing.T) {
+	a := NewWithOptions(WithNestedStructs(true))
+	errBad := errors.New("bad city")
+	a.RegisterValidatorFor(nestedModelAddress{}, "City", func(v interface{}) error {
+		if v.(string) == "" {
+			return errBad
+		}
+		return nil
+	})
+	err := a.Into(&nestedModelContact{}, &nestedTypeContact{Address: &nestedTypeAddress{Street: "x"}})
+	require.ErrorIs(t, err, errBad)
+	assert.Contains(t, err.Error(), "adapting field Address")
+}
+
+func TestNestedStructs_CheckMapping(t *testing.T) {
+	r := NewWithOptions(WithNestedStructs(true)).CheckMapping(nestedTypeContact{}, nestedModelContact{})
+	require.NoError(t, r.Err)
+	assert.ElementsMatch(t, []string{"Call", "Address", "Home"}, r.Matched)
+	assert.Empty(t, r.Incompatible)
+
+	r = New().CheckMapping(nestedTypeContact{}, nestedModelContact{})
+	assert.ElementsMatch(t, []string{"Address", "Home"}, r.Incompatible)
+}
+
+type nestedTypeItem struct {
+	Band string
+	Mode string
+}
+
+type nestedModelItem struct {
+	Band     string
+	Mode     string
+	Uploaded bool
+}
+
+type nestedTypeLog struct {
+	Call  string
+	Items []nestedTypeItem
+	Refs  []*nestedTypeItem
+}
+
+type nestedModelLog struct {
+	Call  string
+	Items []*nestedModelItem
+	Refs  []nestedModelItem
+}
+
+func TestNestedStructs_SliceTypeToModel(t *testing.T) {
+	a := NewWithOptions(WithNestedStructs(true))
+	dst := &nestedModelLog{}
+	src := &nestedTypeLog{
+		Call:  "M0CMC",
+		Items: []nestedTypeItem{{Band: "20m", Mode: "SSB"}, {Band: "40m", Mode: "CW"}},
+		Refs:  []*nestedTypeItem{{Band: "2m"}, nil},
+	}
+	require.NoError(t, a.Into(dst, src))
+	require.Len(t, dst.Items, 2)
+	assert.Equal(t, nestedModelItem{Band: "20m", Mode: "SSB"}, *dst.Items[0])
+	assert.Equal(t, nestedModelItem{Band: "40m", Mode: "CW"}, *dst.Items[1])
+	assert.Equal(t, []nestedModelItem{{Band: "2m"}, {}}, dst.Refs, "a nil element becomes a zero struct")
+}
+
+func TestNestedStructs_SliceModelToType(t *testing.T) {
+	a := NewWithOptions(WithNestedStructs(true))
+	dst := &nestedTypeLog{Items: []nestedTypeItem{{Band: "stale"}}}
+	src := &nestedModelLog{Items: []*nestedModelItem{{Band: "20m", Uploaded: true}}, Refs: nil}
+	require.NoError(t, a.Into(dst, src))
+	assert.Equal(t, []nestedTypeItem{{Band: "20m"}}, dst.Items, "the destination slice is replaced, not merged")
+	assert.Nil(t, dst.Refs, "a nil source slice sets a nil destination")
+}
+
+func TestNestedStructs_ArraySource(t *testing.T) {
+	a := NewWithOptions(WithNestedStructs(true))
+	type S struct{ Items [2]nestedTypeItem }
+	type D struct{ Items []nestedModelItem }
+	dst := &D{}
+	require.NoError(t, a.Into(dst, &S{Items: [2]nestedTypeItem{{Band: "20m"}, {Band: "40m", Mode: "CW"}}}))
+	assert.Equal(t, []nestedModelItem{{Band: "20m"}, {Band: "40m", Mode: "CW"}}, dst.Items)
+	assert.Contains(t, a.CheckMapping(S{}, D{}).Matched, "Items")
+}
+
+func TestNestedStructs_SliceDisabledByDefault(t *testing.T) {
+	dst := &nestedModelLog{}
+	require.NoError(t, New().Into(dst, &nestedTypeLog{Items: []nestedTypeItem{{Band: "20m"}}}))
+	assert.Nil(t, dst.Items)
+}
+
+func TestNestedStructs_SliceErrorNamesFieldAndIndex(t *testing.T) {
+	a := NewWithOptions(WithNestedStructs(true))
+	errBad := errors.New("bad band")
+	a.RegisterValidatorFor(nestedModelItem{}, "Band", func(v interface{}) error {
+		if v.(string) == "" {
+			return errBad
+		}
+		return nil
+	})
+	err := a.Into(&nestedModelLog{}, &nestedTypeLog{Items: []nestedTypeItem{{Band: "20m"}, {Mode: "CW"}}})
+	require.ErrorIs(t, err, errBad)
+	assert.Contains(t, err.Error(), "adapting field Items: element 1")
+}
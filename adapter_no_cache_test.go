@@ -0,0 +1,54 @@
+package adapters
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func syncMapLen(m *sync.Map) int {
+	n := 0
+	m.Range(func(_, _ any) bool { n++; return true })
+	return n
+}
+
+func TestWithoutMetadataCache_NoEntriesAccumulate(t *testing.T) {
+	a := NewWithOptions(WithoutMetadataCache())
+	a.RegisterConverter("Name", func(v interface{}) (interface{}, error) { return v.(string) + "!", nil })
+	for i := 0; i < 10; i++ {
+		src := struct {
+			Name string
+			N    int
+		}{Name: "x", N: i}
+		dst := struct {
+			Name string
+			N    int64
+		}{}
+		require.NoError(t, a.Into(&dst, &src))
+		assert.Equal(t, "x!", dst.Name)
+		assert.Equal(t, int64(i), dst.N)
+	}
+	a.WarmMetadata(struct{ A int }{})
+	assert.Zero(t, syncMapLen(&a.metadataCache))
+	assert.Zero(t, syncMapLen(&a.planCache))
+}
+
+func TestWithoutMetadataCache_DefaultCaches(t *testing.T) {
+	a := New()
+	type S struct{ Name string }
+	type D struct{ Name string }
+	require.NoError(t, a.Into(&D{}, &S{Name: "x"}))
+	assert.Equal(t, 2, syncMapLen(&a.metadataCache))
+	assert.Equal(t, 1, syncMapLen(&a.planCache))
+}
+
+func TestWithoutMetadataCache_BuildErrorsStillReported(t *testing.T) {
+	type S struct{ Call string }
+	a := NewWithOptions(WithoutMetadataCache())
+	err := a.Into(reflect.New(dupJSONType("call")).Interface(), &S{Call: "a"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "share json name")
+}
@@ -0,0 +1,170 @@
+package adapters
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type naModel struct {
+	Name  null.String
+	Count null.Int
+	Freq  null.Int64
+	On    null.Bool
+	Power null.Float64
+	When  null.Time
+}
+
+type naType struct {
+	Name  string
+	Count int
+	Freq  int64
+	On    bool
+	Power float64
+	When  time.Time
+}
+
+func TestNullAware_ModelToType(t *testing.T) {
+	a := NewWithOptions(WithNullAware(true))
+	now := time.Now().UTC()
+	s := naModel{
+		Name:  null.StringFrom("G0ABC"),
+		Count: null.IntFrom(3),
+		Freq:  null.Int64From(14320000),
+		On:    null.BoolFrom(true),
+		Power: null.Float64From(100.5),
+		When:  null.TimeFrom(now),
+	}
+	d := naType{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, naType{Name: "G0ABC", Count: 3, Freq: 14320000, On: true, Power: 100.5, When: now}, d)
+}
+
+func TestNullAware_InvalidBecomesZero(t *testing.T) {
+	a := NewWithOptions(WithNullAware(true))
+	s := naModel{}
+	d := naType{Name: "old", Count: 9}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, "", d.Name)
+	assert.Equal(t, 0, d.Count)
+}
+
+func TestNullAware_TypeToModel(t *testing.T) {
+	a := NewWithOptions(WithNullAware(true))
+	s := naType{Name: "", Count: 0, Freq: 7074000, On: false}
+	d := naModel{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.True(t, d.Name.Valid)
+	assert.Equal(t, "", d.Name.String)
+	assert.True(t, d.Count.Valid)
+	assert.Equal(t, null.Int64From(7074000), d.Freq)
+	assert.True(t, d.On.Valid)
+}
+
+func TestNullAware_EmptyStringAsNull(t *testing.T) {
+	a := NewWithOptions(WithNullAware(true), WithEmptyStringAsNull(true))
+	s := naType{Name: ""}
+	d := naModel{Name: null.StringFrom("old")}
+	require.NoError(t, a.Into(&d, &s))
+	assert.False(t, d.Name.Valid)
+}
+
+func TestNullAware_DisabledByDefault(t *testing.T) {
+	a := New()
+	s := naModel{Name: null.StringFrom("x")}
+	d := naType{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, "", d.Name)
+}
+
+func TestNullAware_IncompatibleInnerSkipped(t *testing.T) {
+	a := NewWithOptions(WithNullAware(true))
+	type S struct{ V null.Time }
+	type D struct{ V string }
+	s := S{V: null.TimeFrom(time.Now())}
+	d := D{V: "keep"}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, "keep", d.V)
+}
+
+func TestNullAware_IntToStringSkipped(t *testing.T) {
+	a := NewWithOptions(WithNullAware(true))
+	type S struct{ X null.Int }
+	type D struct{ X string }
+	d := D{X: "keep"}
+	require.NoError(t, a.Into(&d, &S{X: null.IntFrom(65)}))
+	assert.Equal(t, "keep", d.X, "an integer must not become the string of its code point")
+
+	type SP struct{ X int }
+	type DP struct{ X null.String }
+	dp := DP{}
+	require.NoError(t, a.Into(&dp, &SP{X: 65}))
+	assert.False(t, dp.X.Valid)
+}
+
+func TestNullAware_SQLNullString(t *testing.T) {
+	a := NewWithOptions(WithNullAware(true))
+	type M struct{ Name sql.NullString }
+	type T struct{ Name string }
+	m := M{Name: sql.NullString{String: "x", Valid: true}}
+	d := T{}
+	require.NoError(t, a.Into(&d, &m))
+	assert.Equal(t, "x", d.Name)
+
+	back := M{}
+	require.NoError(t, a.Into(&back, &d))
+	assert.Equal(t, sql.NullString{String: "x", Valid: true}, back.Name)
+
+	m = M{}
+	d = T{Name: "old"}
+	require.NoError(t, a.Into(&d, &m))
+	assert.Equal(t, "", d.Name)
+}
+
+func TestNullAware_SQLNullInt64(t *testing.T) {
+	a := NewWithOptions(WithNullAware(true))
+	type M struct{ Freq sql.NullInt64 }
+	type T struct{ Freq int64 }
+	m := M{Freq: sql.NullInt64{Int64: 14320000, Valid: true}}
+	d := T{}
+	require.NoError(t, a.Into(&d, &m))
+	assert.Equal(t, int64(14320000), d.Freq)
+
+	back := M{}
+	require.NoError(t, a.Into(&back, &d))
+	assert.Equal(t, sql.NullInt64{Int64: 14320000, Valid: true}, back.Freq)
+}
+
+type customNull struct {
+	Present bool
+	Value   string
+	extra   int // makes the wrapper fail shape detection
+}
+
+func TestNullAware_RegisterNullType(t *testing.T) {
+	a := NewWithOptions(WithNullAware(true))
+	require.NoError(t, a.RegisterNullType(customNull{}, "Value", "Present"))
+	type M struct{ Name customNull }
+	type T struct{ Name string }
+	m := M{Name: customNull{Present: true, Value: "v"}}
+	d := T{}
+	require.NoError(t, a.Into(&d, &m))
+	assert.Equal(t, "v", d.Name)
+
+	back := M{}
+	require.NoError(t, a.Into(&back, &d))
+	assert.True(t, back.Name.Present)
+	assert.Equal(t, "v", back.Name.Value)
+}
+
+func TestRegisterNullType_Errors(t *testing.T) {
+	a := New()
+	assert.Error(t, a.RegisterNullType(nil, "Value", "Present"))
+	assert.Error(t, a.RegisterNullType(1, "Value", "Present"))
+	assert.Error(t, a.RegisterNullType(customNull{}, "Missing", "Present"))
+	assert.Error(t, a.RegisterNullType(customNull{}, "Value", "Value"))
+}
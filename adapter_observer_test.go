@@ -0,0 +1,66 @@
+package adapters
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheObserver_PlanAndMetadataEvents(t *testing.T) {
+	var mu sync.Mutex
+	var events []CacheEvent
+	a := NewWithOptions(WithCacheObserver(func(e CacheEvent) {
+		mu.Lock()
+		events = append(events, e)
+		mu.Unlock()
+	}))
+	type S struct{ Name string }
+	type D struct{ Name string }
+	s := S{Name: "x"}
+	d := D{}
+	require.NoError(t, a.Into(&d, &s))
+	require.NoError(t, a.Into(&d, &s))
+
+	var planHits, planMisses, metaMisses int
+	for _, e := range events {
+		switch e.Cache {
+		case PlanCache:
+			assert.Equal(t, reflect.TypeOf(S{}), e.SrcType)
+			assert.Equal(t, reflect.TypeOf(D{}), e.DstType)
+			assert.Equal(t, uint64(1), e.Gen)
+			if e.Hit {
+				planHits++
+			} else {
+				planMisses++
+			}
+		case MetadataCache:
+			if !e.Hit {
+				metaMisses++
+			}
+		}
+	}
+	assert.Equal(t, 1, planMisses)
+	assert.Equal(t, 1, planHits)
+	assert.Equal(t, 2, metaMisses)
+}
+
+func TestCacheObserver_GenInvalidationIsMiss(t *testing.T) {
+	var last CacheEvent
+	a := NewWithOptions(WithCacheObserver(func(e CacheEvent) {
+		if e.Cache == PlanCache {
+			last = e
+		}
+	}))
+	type S struct{ Name string }
+	type D struct{ Name string }
+	s := S{Name: "x"}
+	d := D{}
+	require.NoError(t, a.Into(&d, &s))
+	a.RegisterConverter("Other", func(v any) (any, error) { return v, nil })
+	require.NoError(t, a.Into(&d, &s))
+	assert.False(t, last.Hit)
+	assert.Equal(t, uint64(2), last.Gen)
+}
@@ -0,0 +1,104 @@
+package adapters
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withProcs raises GOMAXPROCS for the test so the parallel path runs even on single-CPU machines.
+func withProcs(t *testing.T, n int) {
+	prev := runtime.GOMAXPROCS(n)
+	t.Cleanup(func() { runtime.GOMAXPROCS(prev) })
+}
+
+func largeBenchSourceFilled() *largeBenchSource {
+	s := &largeBenchSource{}
+	v := reflect.ValueOf(s).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		switch f := v.Field(i); f.Kind() {
+		case reflect.String:
+			f.SetString(fmt.Sprintf("s%d", i))
+		case reflect.Int:
+			f.SetInt(int64(i))
+		case reflect.Float64:
+			f.SetFloat(float64(i) + 0.5)
+		case reflect.Bool:
+			f.SetBool(i%2 == 0)
+		}
+	}
+	return s
+}
+
+func TestParallel_MatchesSequential(t *testing.T) {
+	withProcs(t, 4)
+	src := largeBenchSourceFilled()
+	var calls atomic.Int32
+	upper := func(v interface{}) (interface{}, error) { calls.Add(1); return v.(string) + "!", nil }
+
+	seq := New().WithConverter("F1", upper).WithConverter("F50", upper)
+	par := NewWithOptions(WithParallelThreshold(8)).WithConverter("F1", upper).WithConverter("F50", upper)
+	want, got := largeBenchDest{}, largeBenchDest{}
+	require.NoError(t, seq.Into(&want, src))
+	for i := 0; i < 50; i++ {
+		got = largeBenchDest{}
+		require.NoError(t, par.Into(&got, src))
+		require.Equal(t, want, got)
+	}
+	assert.Equal(t, int32(102), calls.Load())
+	assert.True(t, par.runsParallel(par.getPlan(reflect.TypeOf(largeBenchSource{}), reflect.TypeOf(largeBenchDest{}))))
+}
+
+func TestParallel_FirstErrorInFieldOrder(t *testing.T) {
+	withProcs(t, 4)
+	a := NewWithOptions(WithParallelThreshold(8))
+	a.RegisterValidator("F3", func(interface{}) error { return errors.New("F3 bad") })
+	a.RegisterValidator("F48", func(interface{}) error { return errors.New("F48 bad") })
+	for i := 0; i < 20; i++ {
+		require.EqualError(t, a.Into(&largeBenchDest{}, largeBenchSourceFilled()), "F3 bad")
+	}
+}
+
+func TestParallel_AdditionalDataBookkeeping(t *testing.T) {
+	withProcs(t, 4)
+	type Dst struct {
+		largeBenchDest
+		AdditionalData null.JSON
+	}
+	type Src struct {
+		largeBenchSource
+		Extra string
+	}
+	a := NewWithOptions(WithParallelThreshold(8))
+	d := Dst{}
+	require.NoError(t, a.Into(&d, &Src{largeBenchSource: *largeBenchSourceFilled(), Extra: "x"}))
+	assert.Equal(t, "s0", d.F1)
+	assert.JSONEq(t, `{"Extra":"x"}`, string(d.AdditionalData.JSON), "copied fields are not marshaled")
+}
+
+func TestParallel_UnsafePlansRunSequentially(t *testing.T) {
+	withProcs(t, 4)
+	a := NewWithOptions(WithParallelThreshold(1))
+	type Inner struct{ A, B string }
+	type Dst struct {
+		*Inner
+		C string
+	}
+	type Src struct{ A, B, C string }
+	d := Dst{}
+	require.NoError(t, a.Into(&d, &Src{A: "a", B: "b", C: "c"}))
+	assert.Equal(t, Dst{Inner: &Inner{A: "a", B: "b"}, C: "c"}, d)
+	assert.False(t, a.runsParallel(a.getPlan(reflect.TypeOf(Src{}), reflect.TypeOf(Dst{}))))
+
+	// at or below the threshold, or with the default of 0, plans stay sequential
+	flat := a.getPlan(reflect.TypeOf(largeBenchSource{}), reflect.TypeOf(largeBenchDest{}))
+	assert.False(t, NewWithOptions(WithParallelThreshold(50)).runsParallel(flat))
+	assert.False(t, New().runsParallel(flat))
+}
@@ -0,0 +1,53 @@
+package adapters
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type pipelineSrc struct {
+	Callsign string
+	Freq     string
+}
+
+type pipelineDst struct {
+	Call string
+	Freq string
+}
+
+func TestRunPipeline_TwoStages(t *testing.T) {
+	mapStage := New()
+	mapStage.RegisterFieldFanout("Callsign", "Call")
+	normalize := New().
+		WithConverter("Call", func(v interface{}) (interface{}, error) { return strings.ToUpper(strings.TrimSpace(v.(string))), nil }).
+		WithConverter("Freq", func(v interface{}) (interface{}, error) { return strings.TrimSuffix(v.(string), " MHz"), nil })
+
+	dst := &pipelineDst{}
+	require.NoError(t, RunPipeline(dst, &pipelineSrc{Callsign: " m0cmc ", Freq: "14.074 MHz"}, mapStage, normalize))
+	assert.Equal(t, pipelineDst{Call: "M0CMC", Freq: "14.074"}, *dst)
+}
+
+func TestRunPipeline_StageError(t *testing.T) {
+	boom := errors.New("empty call")
+	validate := New().WithValidator("Call", func(v interface{}) error {
+		if v.(string) == "" {
+			return boom
+		}
+		return nil
+	})
+	dst := &pipelineDst{}
+	err := RunPipeline(dst, &pipelineDst{Freq: "7.074"}, New(), validate)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, boom)
+	assert.Contains(t, err.Error(), "pipeline stage 1")
+	assert.Equal(t, "7.074", dst.Freq, "earlier stages keep their writes")
+}
+
+func TestRunPipeline_Invalid(t *testing.T) {
+	assert.Error(t, RunPipeline(&pipelineDst{}, &pipelineDst{}))
+	assert.Error(t, RunPipeline(&pipelineDst{}, &pipelineDst{}, New(), nil))
+}
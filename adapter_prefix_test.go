@@ -0,0 +1,99 @@
+package adapters
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type pfStation struct {
+	Call string `json:"call"`
+	Grid string
+}
+
+type pfQso struct {
+	pfStation `adapter:"prefix=My"`
+}
+
+type pfEmbedPair struct {
+	pfStation `adapter:"prefix=Station"`
+	pfOther   `adapter:"prefix=Contacted"`
+}
+
+type pfOther struct {
+	pfStation
+}
+
+type pfFlat struct {
+	StationCall    string
+	StationGrid    string
+	ContactedCall  string
+	ContactedGrid  string
+	AdditionalData null.JSON
+}
+
+func TestPrefix_TwoEmbedsSameInnerNames(t *testing.T) {
+	a := New()
+	s := pfEmbedPair{
+		pfStation: pfStation{Call: "G0ABC", Grid: "IO91"},
+		pfOther:   pfOther{pfStation{Call: "M0XYZ", Grid: "JO01"}},
+	}
+	d := pfFlat{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, "G0ABC", d.StationCall)
+	assert.Equal(t, "IO91", d.StationGrid)
+	assert.Equal(t, "M0XYZ", d.ContactedCall)
+	assert.Equal(t, "JO01", d.ContactedGrid)
+	assert.False(t, d.AdditionalData.Valid)
+
+	back := pfEmbedPair{}
+	require.NoError(t, a.Into(&back, &d))
+	assert.Equal(t, s, back)
+}
+
+func TestPrefix_MetadataNames(t *testing.T) {
+	a := New()
+	meta := a.getOrBuildMetadata(reflect.TypeOf(pfEmbedPair{}))
+	require.NoError(t, meta.buildErr)
+	for _, n := range []string{"StationCall", "StationGrid", "ContactedCall", "ContactedGrid"} {
+		assert.Contains(t, meta.fieldsByName, n)
+	}
+	assert.NotContains(t, meta.fieldsByName, "Call")
+	// json names are prefixed with the snake_case prefix, so the two inner `call` tags do not clash
+	assert.Contains(t, meta.fieldsByJSONName, "station_call")
+	assert.Contains(t, meta.fieldsByJSONName, "contacted_call")
+}
+
+func TestPrefix_AdditionalDataUsesPrefixedNames(t *testing.T) {
+	a := New()
+	type Src struct {
+		AdditionalData null.JSON
+	}
+	raw, _ := json.Marshal(map[string]any{"StationGrid": "IO91", "contacted_call": "M0XYZ"})
+	d := pfEmbedPair{}
+	require.NoError(t, a.Into(&d, &Src{AdditionalData: null.JSONFrom(raw)}))
+	assert.Equal(t, "IO91", d.pfStation.Grid)
+	assert.Equal(t, "M0XYZ", d.pfOther.Call)
+
+	type Dst struct {
+		StationCall    string
+		AdditionalData null.JSON
+	}
+	out := Dst{}
+	require.NoError(t, a.Into(&out, &pfEmbedPair{pfStation: pfStation{Call: "G0ABC", Grid: "IO91"}}))
+	assert.Equal(t, "G0ABC", out.StationCall)
+	assert.JSONEq(t, `{"StationGrid":"IO91"}`, string(out.AdditionalData.JSON))
+}
+
+func TestPrefix_UnprefixedNamesNoLongerMatch(t *testing.T) {
+	a := New()
+	type D struct{ Call, MyCall string }
+	d := D{}
+	require.NoError(t, a.Into(&d, &pfQso{pfStation: pfStation{Call: "G0ABC"}}))
+	assert.Equal(t, "", d.Call)
+	assert.Equal(t, "G0ABC", d.MyCall)
+}
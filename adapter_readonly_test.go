@@ -0,0 +1,68 @@
+package adapters
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roModel struct {
+	ID             int64  `adapter:"readonly"`
+	CreatedAt      string `json:"created_at" adapter:"readonly"`
+	Call           string
+	AdditionalData null.JSON
+}
+
+type roType struct {
+	ID             int64
+	CreatedAt      string `json:"created_at"`
+	Call           string
+	AdditionalData null.JSON
+}
+
+func TestReadonly_NotWrittenAsDestination(t *testing.T) {
+	a := New()
+	s := roType{ID: 99, CreatedAt: "now", Call: "G0ABC"}
+	d := roModel{ID: 1, CreatedAt: "db"}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, int64(1), d.ID)
+	assert.Equal(t, "db", d.CreatedAt)
+	assert.Equal(t, "G0ABC", d.Call)
+	// the matched source values are consumed, not diverted into AdditionalData
+	assert.False(t, d.AdditionalData.Valid)
+}
+
+func TestReadonly_ReadAsSource(t *testing.T) {
+	a := New()
+	s := roModel{ID: 7, CreatedAt: "db", Call: "G0ABC"}
+	d := roType{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, roType{ID: 7, CreatedAt: "db", Call: "G0ABC"}, d)
+}
+
+func TestReadonly_NotWrittenFromAdditionalData(t *testing.T) {
+	a := New()
+	raw, err := json.Marshal(map[string]any{"ID": 5, "created_at": "ad"})
+	require.NoError(t, err)
+	type Src struct {
+		Call           string
+		AdditionalData null.JSON
+	}
+	s := Src{Call: "x", AdditionalData: null.JSONFrom(raw)}
+	d := roModel{ID: 1, CreatedAt: "db"}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, int64(1), d.ID)
+	assert.Equal(t, "db", d.CreatedAt)
+}
+
+func TestReadonly_CheckMapping(t *testing.T) {
+	a := New()
+	r := a.CheckMapping(roType{}, roModel{})
+	require.NoError(t, r.Err)
+	assert.Equal(t, []string{"Call"}, r.Matched)
+	assert.Empty(t, r.ToAdditionalData)
+	assert.Empty(t, r.DestinationOnly)
+}
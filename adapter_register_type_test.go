@@ -0,0 +1,52 @@
+package adapters
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type rtSrc struct{ Call string }
+type rtDst struct{ Call string }
+
+func TestRegisterConverterForType(t *testing.T) {
+	a := New()
+	a.RegisterConverterForType(reflect.TypeOf(rtDst{}), "Call", func(interface{}) (interface{}, error) { return "dst", nil })
+	d := rtDst{}
+	require.NoError(t, a.Into(&d, &rtSrc{Call: "x"}))
+	assert.Equal(t, "dst", d.Call)
+
+	// pointer types resolve to their element type; pair scope wins
+	a.RegisterConverterForPairTypes(reflect.TypeOf(&rtSrc{}), reflect.TypeOf(&rtDst{}), "Call", func(interface{}) (interface{}, error) { return "pair", nil })
+	require.NoError(t, a.Into(&d, &rtSrc{Call: "x"}))
+	assert.Equal(t, "pair", d.Call)
+}
+
+func TestRegisterValidatorForType(t *testing.T) {
+	a := New()
+	a.RegisterValidatorForType(reflect.TypeOf(&rtDst{}), "Call", func(v interface{}) error {
+		if v.(string) == "" {
+			return errors.New("empty")
+		}
+		return nil
+	})
+	assert.Error(t, a.Into(&rtDst{}, &rtSrc{}))
+	assert.NoError(t, a.Into(&rtDst{}, &rtSrc{Call: "x"}))
+
+	a.RegisterValidatorForPairTypes(reflect.TypeOf(rtSrc{}), reflect.TypeOf(rtDst{}), "Call", func(interface{}) error { return errors.New("pair") })
+	err := a.Into(&rtDst{}, &rtSrc{Call: "x"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pair")
+}
+
+func TestRegisterForType_SameScopeAsAnyBased(t *testing.T) {
+	a := New()
+	fn := func(interface{}) (interface{}, error) { return "c", nil }
+	a.RegisterConverterForType(reflect.TypeOf(&rtDst{}), "Call", fn)
+	reg := a.converters.Load().(*converterRegistry)
+	assert.Contains(t, reg.byDst, reflect.TypeOf(rtDst{}))
+	assert.Len(t, reg.byDst, 1)
+}
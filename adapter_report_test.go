@@ -0,0 +1,108 @@
+package adapters
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type reportSrc struct {
+	Call           string
+	Freq           string
+	Power          []int
+	Rig            string
+	Antenna        string
+	Secret         string `adapter:"ignore"`
+	ID             int64
+	AdditionalData null.JSON
+}
+
+type reportDst struct {
+	Call           string
+	Freq           int64
+	Power          string
+	ID             int64 `adapter:"readonly"`
+	AdditionalData null.JSON
+}
+
+func TestIntoReport_Dispositions(t *testing.T) {
+	a := New()
+	a.RegisterConverterForPair(reportSrc{}, reportDst{}, "Freq", func(v interface{}) (interface{}, error) {
+		return strconv.ParseInt(v.(string), 10, 64)
+	})
+	dst := &reportDst{}
+	src := &reportSrc{Call: "M0CMC", Freq: "14320000", Power: []int{100}, Rig: "IC-7300", Secret: "x", ID: 7}
+	r, err := a.IntoReport(dst, src)
+	require.NoError(t, err)
+	assert.Equal(t, []FieldDisposition{
+		{Field: "Call", Disposition: Copied, Dst: "Call"},
+		{Field: "Freq", Disposition: Converted, Dst: "Freq", Detail: "pair"},
+		{Field: "Power", Disposition: Dropped, Dst: "Power", Detail: "incompatible"},
+		{Field: "Rig", Disposition: MarshaledToAD},
+		{Field: "Antenna", Disposition: Dropped, Detail: "zero"},
+		{Field: "Secret", Disposition: Ignored, Detail: "ignore"},
+		{Field: "ID", Disposition: Ignored, Detail: "readonly"},
+	}, r.Fields)
+	assert.Equal(t, int64(14320000), dst.Freq, "the adaptation itself is unaffected")
+	assert.JSONEq(t, `{"Rig":"IC-7300"}`, string(dst.AdditionalData.JSON))
+
+	f, ok := r.Field("Rig")
+	require.True(t, ok)
+	assert.Equal(t, MarshaledToAD, f.Disposition)
+	_, ok = r.Field("Missing")
+	assert.False(t, ok)
+	assert.Equal(t, "Call=copied(Call) Freq=converted(Freq,pair) Power=dropped(Power,incompatible) Rig=additional "+
+		"Antenna=dropped(zero) Secret=ignored(ignore) ID=ignored(readonly)", r.String())
+}
+
+func TestIntoReport_NoAdditionalDataSink(t *testing.T) {
+	type src struct{ Call, Rig string }
+	type dst struct{ Call string }
+	r, err := New().IntoReport(&dst{}, &src{Call: "M0CMC", Rig: "IC-7300"})
+	require.NoError(t, err)
+	f, _ := r.Field("Rig")
+	assert.Equal(t, FieldDisposition{Field: "Rig", Disposition: Dropped, Detail: "unmatched"}, f)
+}
+
+func TestIntoReport_Fallback(t *testing.T) {
+	a := NewWithOptions(WithFallbackConverter(func(v any, dt reflect.Type) (any, error) {
+		if dt.Kind() != reflect.String {
+			return nil, Skip
+		}
+		return "100 W", nil
+	}))
+	r, err := a.IntoReport(&reportDst{}, &reportSrc{Power: []int{100}})
+	require.NoError(t, err)
+	f, _ := r.Field("Power")
+	assert.Equal(t, FieldDisposition{Field: "Power", Disposition: Converted, Dst: "Power", Detail: "fallback"}, f)
+}
+
+func TestIntoReport_NestedNotReported(t *testing.T) {
+	type inner struct{ Band string }
+	type innerDst struct{ Band string }
+	type src struct {
+		Call    string
+		Details *inner
+	}
+	type dst struct {
+		Call    string
+		Details *innerDst
+	}
+	r, err := NewWithOptions(WithNestedStructs(true)).IntoReport(&dst{}, &src{Call: "M0CMC", Details: &inner{Band: "20m"}})
+	require.NoError(t, err)
+	assert.Equal(t, "Call=copied(Call) Details=copied(Details)", r.String())
+}
+
+func TestIntoReport_Error(t *testing.T) {
+	a := New()
+	a.RegisterConverter("Freq", func(v interface{}) (interface{}, error) {
+		return strconv.ParseInt(v.(string), 10, 64)
+	})
+	r, err := a.IntoReport(&reportDst{}, &reportSrc{Freq: "abc"})
+	require.Error(t, err)
+	assert.Empty(t, r.Fields)
+}
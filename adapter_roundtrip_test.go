@@ -0,0 +1,42 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	type In struct {
+		Call   string `json:"call"`
+		Power  int    `json:"power"`
+		secret string
+		Skip   string `json:"-"`
+	}
+	type Out struct {
+		Call  string  `json:"call"`
+		Power float64 `json:"power"`
+		Skip  string
+	}
+	out := Out{}
+	require.NoError(t, JSONRoundTrip(In{Call: "G0ABC", Power: 100, secret: "s", Skip: "x"}, &out))
+	assert.Equal(t, Out{Call: "G0ABC", Power: 100}, out)
+
+	var generic map[string]any
+	require.NoError(t, JSONRoundTrip(In{Power: 5}, &generic))
+	assert.Equal(t, float64(5), generic["power"], "numbers decoded into interface{} become float64")
+}
+
+func TestJSONRoundTrip_Errors(t *testing.T) {
+	var out struct{ Call int }
+	err := JSONRoundTrip(struct{ Call string }{"x"}, &out)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unmarshaling into")
+
+	err = JSONRoundTrip(map[string]any{"c": make(chan int)}, &out)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "marshaling")
+
+	assert.Error(t, JSONRoundTrip[int, int](1, nil))
+}
@@ -0,0 +1,71 @@
+package adapters
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type simpleSrc struct {
+	Call  string
+	Freq  int64
+	Notes []string
+}
+
+type simpleDst struct {
+	Notes []string
+	Call  string
+	Freq  int64
+}
+
+func simplePlan(a *Adapter, src, dst interface{}) []simpleCopy {
+	return a.getPlan(reflect.TypeOf(src), reflect.TypeOf(dst)).simple
+}
+
+func TestSimpleCopy_Detected(t *testing.T) {
+	a := New()
+	dst := &simpleDst{}
+	require.NoError(t, a.Into(dst, &simpleSrc{Call: "M0CMC", Freq: 14074000, Notes: []string{"ft8"}}))
+	assert.Equal(t, simpleDst{Call: "M0CMC", Freq: 14074000, Notes: []string{"ft8"}}, *dst)
+	assert.Equal(t, []simpleCopy{{src: 2, dst: 0}, {src: 0, dst: 1}, {src: 1, dst: 2}}, simplePlan(a, simpleSrc{}, simpleDst{}))
+}
+
+func TestSimpleCopy_InvalidatedByRegistration(t *testing.T) {
+	a := New()
+	require.NoError(t, a.Into(&simpleDst{}, &simpleSrc{}))
+	require.NotNil(t, simplePlan(a, simpleSrc{}, simpleDst{}))
+
+	a.RegisterConverter("Call", func(v interface{}) (interface{}, error) { return strings.ToUpper(v.(string)), nil })
+	dst := &simpleDst{}
+	require.NoError(t, a.Into(dst, &simpleSrc{Call: "m0cmc"}))
+	assert.Equal(t, "M0CMC", dst.Call)
+	assert.Nil(t, simplePlan(a, simpleSrc{}, simpleDst{}))
+}
+
+func TestSimpleCopy_NotUsed(t *testing.T) {
+	type convertible struct {
+		Call string
+		Freq int
+	}
+	type withAD struct {
+		Call           string
+		AdditionalData null.JSON
+	}
+	a := New()
+	assert.Nil(t, simplePlan(a, convertible{}, simpleDst{}), "implicit conversions take the regular path")
+	assert.Nil(t, simplePlan(a, withAD{}, simpleDst{}))
+	assert.Nil(t, simplePlan(a, simpleSrc{}, withAD{}))
+
+	a.RegisterValidator("Freq", func(v interface{}) error { return nil })
+	assert.Nil(t, simplePlan(a, simpleSrc{}, simpleDst{}))
+}
+
+func TestSimpleCopy_ReportStillRecorded(t *testing.T) {
+	r, err := New().IntoReport(&simpleDst{}, &simpleSrc{Call: "M0CMC"})
+	require.NoError(t, err)
+	assert.Equal(t, "Call=copied(Call) Freq=copied(Freq) Notes=copied(Notes)", r.String())
+}
@@ -0,0 +1,56 @@
+package adapters
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type skipEvent struct {
+	field    string
+	src, dst reflect.Type
+}
+
+func recordSkips(events *[]skipEvent) Option {
+	return WithOnFieldSkipped(func(field string, srcType, dstType reflect.Type) {
+		*events = append(*events, skipEvent{field, srcType, dstType})
+	})
+}
+
+func TestOnFieldSkipped_IncompatibleTypes(t *testing.T) {
+	var events []skipEvent
+	a := NewWithOptions(recordSkips(&events))
+	dst := &DestIncompatible{}
+	require.NoError(t, a.Into(dst, &SourceIncompatible{Name: "Test", Data: []byte("data")}))
+	assert.Equal(t, "Test", dst.Name)
+	assert.Nil(t, dst.Data, "the field is still skipped")
+	assert.Equal(t, []skipEvent{{"Data", reflect.TypeOf([]byte(nil)), reflect.TypeOf(map[string]string(nil))}}, events)
+}
+
+func TestOnFieldSkipped_NotFiredForCopiedFields(t *testing.T) {
+	var events []skipEvent
+	a := NewWithOptions(recordSkips(&events))
+	type S struct {
+		Name string
+		N    int32
+	}
+	type D struct {
+		Name string
+		N    int64
+	}
+	a.RegisterConverter("Name", func(v interface{}) (interface{}, error) { return v, nil })
+	require.NoError(t, a.Into(&D{}, &S{Name: "x", N: 1}))
+	assert.Empty(t, events)
+}
+
+func TestOnFieldSkipped_FallbackSkip(t *testing.T) {
+	var events []skipEvent
+	a := NewWithOptions(recordSkips(&events), WithFallbackConverter(func(interface{}, reflect.Type) (interface{}, error) {
+		return nil, Skip
+	}))
+	require.NoError(t, a.Into(&DestIncompatible{}, &SourceIncompatible{Data: []byte("d")}))
+	require.Len(t, events, 1)
+	assert.Equal(t, "Data", events[0].field)
+}
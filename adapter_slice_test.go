@@ -0,0 +1,170 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type slSrc struct{ Name string }
+type slDst struct{ Name string }
+
+func TestAppendAdapted_GrowsSlice(t *testing.T) {
+	a := New()
+	out := []slDst{{Name: "existing"}}
+	for _, n := range []string{"a", "b"} {
+		s := slSrc{Name: n}
+		require.NoError(t, a.AppendAdapted(&out, &s))
+	}
+	require.Len(t, out, 3)
+	assert.Equal(t, "existing", out[0].Name)
+	assert.Equal(t, "a", out[1].Name)
+	assert.Equal(t, "b", out[2].Name)
+}
+
+func TestAppendAdapted_PointerElements(t *testing.T) {
+	a := New()
+	var out []*slDst
+	s := slSrc{Name: "p"}
+	require.NoError(t, a.AppendAdapted(&out, &s))
+	require.Len(t, out, 1)
+	assert.Equal(t, "p", out[0].Name)
+}
+
+func TestAppendAdapted_Errors(t *testing.T) {
+	a := New()
+	s := slSrc{Name: "x"}
+	var notSlice slDst
+	assert.Error(t, a.AppendAdapted(&notSlice, &s))
+	var ints []int
+	assert.Error(t, a.AppendAdapted(&ints, &s))
+	var out []slDst
+	assert.Error(t, a.AppendAdapted(out, &s))
+	assert.Error(t, a.AppendAdapted(&out, s))
+	assert.Error(t, a.AppendAdapted(nil, &s))
+}
+
+func TestAdaptSliceCtx_AllElements(t *testing.T) {
+	a := New()
+	src := []slSrc{{Name: "a"}, {Name: "b"}}
+	out := []slDst{{Name: "old"}}
+	require.NoError(t, a.AdaptSliceCtx(context.Background(), &out, src))
+	assert.Equal(t, []slDst{{Name: "a"}, {Name: "b"}}, out)
+
+	var ptrs []*slDst
+	srcPtrs := []*slSrc{{Name: "p"}, nil}
+	require.NoError(t, a.AdaptSliceCtx(context.Background(), &ptrs, &srcPtrs))
+	require.Len(t, ptrs, 2)
+	assert.Equal(t, "p", ptrs[0].Name)
+	assert.Nil(t, ptrs[1])
+}
+
+func cancelAfter(a *Adapter, n int) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	count := 0
+	a.RegisterStructConverter(slDst{}, func(dst, src interface{}) error {
+		count++
+		if count == n {
+			cancel()
+		}
+		return nil
+	})
+	return ctx, cancel
+}
+
+func TestAdaptSliceCtx_CancelKeepsPartial(t *testing.T) {
+	a := New()
+	ctx, cancel := cancelAfter(a, 2)
+	defer cancel()
+	src := []slSrc{{Name: "a"}, {Name: "b"}, {Name: "c"}}
+	var out []slDst
+	err := a.AdaptSliceCtx(ctx, &out, src)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []slDst{{Name: "a"}, {Name: "b"}}, out)
+}
+
+func TestAdaptSliceCtx_CancelRollback(t *testing.T) {
+	a := NewWithOptions(WithRollbackOnCancel(true))
+	ctx, cancel := cancelAfter(a, 1)
+	defer cancel()
+	src := []slSrc{{Name: "a"}, {Name: "b"}}
+	out := []slDst{{Name: "old"}}
+	err := a.AdaptSliceCtx(ctx, &out, src)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []slDst{{Name: "old"}}, out)
+}
+
+func TestAdaptSliceCtx_Errors(t *testing.T) {
+	a := New()
+	var out []slDst
+	assert.Error(t, a.AdaptSliceCtx(context.Background(), &out, slSrc{}))
+	assert.Error(t, a.AdaptSliceCtx(context.Background(), &out, []int{1}))
+	assert.Error(t, a.AdaptSliceCtx(context.Background(), out, []slSrc{}))
+	assert.Error(t, a.AdaptSliceCtx(context.Background(), nil, []slSrc{}))
+}
+
+func TestAdaptMapCtx(t *testing.T) {
+	a := New()
+	src := map[string]slSrc{"x": {Name: "a"}, "y": {Name: "b"}}
+	var out map[string]*slDst
+	require.NoError(t, a.AdaptMapCtx(context.Background(), &out, src))
+	require.Len(t, out, 2)
+	assert.Equal(t, "a", out["x"].Name)
+	assert.Equal(t, "b", out["y"].Name)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var partial map[string]slDst
+	assert.ErrorIs(t, a.AdaptMapCtx(ctx, &partial, src), context.Canceled)
+	assert.Empty(t, partial)
+
+	var badKey map[int]slDst
+	assert.Error(t, a.AdaptMapCtx(context.Background(), &badKey, src))
+	assert.Error(t, a.AdaptMapCtx(context.Background(), &out, []slSrc{}))
+	var notMap []slDst
+	assert.Error(t, a.AdaptMapCtx(context.Background(), &notMap, src))
+}
+
+type allQso struct{ Call string }
+type allSpot struct {
+	Call string
+	Freq int
+}
+type allQsoModel struct{ Call string }
+type allSpotModel struct {
+	Call string
+	Freq int64
+}
+
+func allDst(src interface{}) interface{} {
+	switch src.(type) {
+	case *allQso, allQso:
+		return &allQsoModel{}
+	case *allSpot:
+		return &allSpotModel{}
+	}
+	return nil
+}
+
+func TestAdaptAll_Heterogeneous(t *testing.T) {
+	out, err := New().AdaptAll([]interface{}{&allQso{Call: "A"}, &allSpot{Call: "B", Freq: 7074}, allQso{Call: "C"}}, allDst)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{&allQsoModel{Call: "A"}, &allSpotModel{Call: "B", Freq: 7074}, &allQsoModel{Call: "C"}}, out)
+}
+
+func TestAdaptAll_ErrorsCarryIndex(t *testing.T) {
+	a := New()
+	out, err := a.AdaptAll([]interface{}{&allQso{Call: "A"}, &slSrc{Name: "x"}}, allDst)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "adapting item 1 (*adapters.slSrc)")
+	assert.Equal(t, []interface{}{&allQsoModel{Call: "A"}}, out, "results before the failure are returned")
+
+	_, err = a.AdaptAll([]interface{}{&allQso{}, 42}, func(interface{}) interface{} { return &allQsoModel{} })
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "adapting item 1 (int)")
+
+	_, err = a.AdaptAll(nil, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,76 @@
+package adapters
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToSnakeCase(t *testing.T) {
+	cases := map[string]string{
+		"CallSign": "call_sign",
+		"QSODate":  "qso_date",
+		"ID":       "id",
+		"Freq":     "freq",
+		"TxPwr2":   "tx_pwr2",
+		"RstSent":  "rst_sent",
+		"MyGridSq": "my_grid_sq",
+	}
+	for in, want := range cases {
+		assert.Equal(t, want, toSnakeCase(in), in)
+	}
+}
+
+func TestImplicitSnakeCaseJSON_AdditionalData(t *testing.T) {
+	a := NewWithOptions(WithImplicitSnakeCaseJSON(true))
+	type S struct{ AdditionalData null.JSON }
+	type D struct {
+		CallSign string
+		QSODate  string
+		Band     string `json:"band_name"`
+	}
+	b, _ := json.Marshal(map[string]any{"call_sign": "G0ABC", "qso_date": "20250101", "band_name": "20m"})
+	s := S{AdditionalData: null.JSONFrom(b)}
+	d := D{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, "G0ABC", d.CallSign)
+	assert.Equal(t, "20250101", d.QSODate)
+	assert.Equal(t, "20m", d.Band)
+}
+
+func TestImplicitSnakeCaseJSON_MatchesTaggedSource(t *testing.T) {
+	a := NewWithOptions(WithImplicitSnakeCaseJSON(true))
+	type Model struct {
+		Call string `json:"call_sign"`
+	}
+	type Type struct{ CallSign string }
+	s := Model{Call: "M0XYZ"}
+	d := Type{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, "M0XYZ", d.CallSign)
+}
+
+func TestImplicitSnakeCaseJSON_ExplicitTagWins(t *testing.T) {
+	a := NewWithOptions(WithImplicitSnakeCaseJSON(true))
+	type D struct {
+		CallSign string
+		Other    string `json:"call_sign"`
+		Skipped  string `json:"-"`
+	}
+	meta := a.getOrBuildMetadata(reflect.TypeOf(D{}))
+	require.NoError(t, meta.buildErr)
+	assert.Equal(t, "Other", meta.fieldsByJSONName["call_sign"].name)
+	assert.Equal(t, "", meta.fieldsByName["CallSign"].jsonName)
+	assert.Equal(t, "", meta.fieldsByName["Skipped"].jsonName)
+}
+
+func TestImplicitSnakeCaseJSON_OffByDefault(t *testing.T) {
+	a := New()
+	type D struct{ CallSign string }
+	meta := a.getOrBuildMetadata(reflect.TypeOf(D{}))
+	assert.Equal(t, "", meta.fieldsByName["CallSign"].jsonName)
+}
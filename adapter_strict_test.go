@@ -0,0 +1,95 @@
+package adapters
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stSrc struct {
+	Call string
+	Freq int64
+}
+
+func TestStrict_IdenticalTypesCopied(t *testing.T) {
+	a := NewStrict()
+	d := stSrc{}
+	require.NoError(t, a.Into(&d, &stSrc{Call: "G0ABC", Freq: 14320000}))
+	assert.Equal(t, stSrc{Call: "G0ABC", Freq: 14320000}, d)
+}
+
+func TestStrict_ErrorsOnTypeMismatch(t *testing.T) {
+	a := NewStrict()
+	type D struct {
+		Call string
+		Freq int32
+	}
+	err := a.Into(&D{}, &stSrc{Call: "x", Freq: 1})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "adapting field Freq")
+
+	// the default adapter converts silently
+	d := D{}
+	require.NoError(t, New().Into(&d, &stSrc{Freq: 1}))
+	assert.Equal(t, int32(1), d.Freq)
+}
+
+func TestStrict_ErrorsOnUnmappedSource(t *testing.T) {
+	a := NewStrict()
+	type D struct {
+		Call           string
+		AdditionalData null.JSON
+	}
+	d := D{}
+	err := a.Into(&d, &stSrc{Call: "x", Freq: 1})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Freq")
+	assert.Equal(t, "", d.Call, "nothing is written when the plan drops fields")
+}
+
+func TestStrict_ConverterBridgesMismatch(t *testing.T) {
+	a := NewStrict()
+	a.RegisterConverter("Freq", func(v interface{}) (interface{}, error) {
+		return strconv.FormatInt(v.(int64), 10), nil
+	})
+	type D struct {
+		Call string
+		Freq string
+	}
+	d := D{}
+	require.NoError(t, a.Into(&d, &stSrc{Call: "x", Freq: 7074000}))
+	assert.Equal(t, "7074000", d.Freq)
+}
+
+func TestStrict_IgnoredSourceFieldsAllowed(t *testing.T) {
+	a := NewStrict()
+	type S struct {
+		Call     string
+		Internal string `adapter:"ignore"`
+	}
+	type D struct{ Call string }
+	assert.NoError(t, a.Into(&D{}, &S{Call: "x", Internal: "y"}))
+}
+
+func TestOptions_ErrorOnUnmappedSourceWithAdditionalDataSink(t *testing.T) {
+	a := NewWithOptions(WithErrorOnUnmappedSource(true))
+	type D struct {
+		Call           string
+		AdditionalData null.JSON
+	}
+	d := D{}
+	require.NoError(t, a.Into(&d, &stSrc{Call: "x", Freq: 1}))
+	assert.True(t, d.AdditionalData.Valid)
+}
+
+func TestStrict_CheckMappingFlagsConvertible(t *testing.T) {
+	type D struct {
+		Call string
+		Freq int32
+	}
+	assert.Equal(t, []string{"Freq"}, NewStrict().CheckMapping(stSrc{}, D{}).Incompatible)
+	assert.Empty(t, New().CheckMapping(stSrc{}, D{}).Incompatible)
+}
@@ -0,0 +1,42 @@
+package adapters
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdapterString_Defaults(t *testing.T) {
+	a := New()
+	s := a.String()
+	assert.True(t, strings.HasPrefix(s, fmt.Sprintf("Adapter{gen=%d options={IncludeZeroValues=false ", a.gen.Load())), s)
+	assert.Contains(t, s, " AllowImplicitConvert=true ")
+	assert.Contains(t, s, " CacheObserver=false ")
+	assert.Contains(t, s, "} converters={global=0 dst=0 pair=0 json=0 context=0 value=0 enums=0 marshalTransforms=0 additionalDataConverters=0 bridges=0 fanouts=0 coalesce=0 groups=0} validators={global=0 dst=0 pair=0} structConverters=0 factories=0}")
+	assert.NotContains(t, s, "\n")
+}
+
+func TestAdapterString_Counts(t *testing.T) {
+	noop := func(v interface{}) (interface{}, error) { return v, nil }
+	a := NewWithOptions(WithIncludeZeroValues(true), WithCacheObserver(func(CacheEvent) {}))
+	a.RegisterConverter("A", noop)
+	a.RegisterConverter("B", noop)
+	a.RegisterConverterFor(fpDst{}, "Call", noop)
+	a.RegisterConverterFor(jfModel{}, "Tags", noop)
+	a.RegisterConverterForPair(fpSrc{}, fpDst{}, "Freq", noop)
+	a.RegisterValidator("A", func(interface{}) error { return nil })
+	a.RegisterStructConverter(fpDst{}, func(dst, src interface{}) error { return nil })
+	a.RegisterFactory(fpDst{}, func() interface{} { return &fpDst{} })
+	a.RegisterFieldFanout("A", "B")
+
+	s := a.String()
+	assert.Contains(t, s, fmt.Sprintf("Adapter{gen=%d ", a.gen.Load()))
+	assert.Contains(t, s, "IncludeZeroValues=true")
+	assert.Contains(t, s, "CacheObserver=true")
+	assert.Contains(t, s, "converters={global=2 dst=2 pair=1 ")
+	assert.Contains(t, s, " fanouts=1 ")
+	assert.Contains(t, s, "validators={global=1 dst=0 pair=0} structConverters=1 factories=1}")
+	assert.Equal(t, s, fmt.Sprint(a))
+}
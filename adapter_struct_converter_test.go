@@ -0,0 +1,73 @@
+package adapters
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type scSrc struct {
+	QsoDate  time.Time
+	Duration time.Duration
+}
+
+type scDst struct {
+	QsoDate    time.Time
+	QsoDateOff time.Time
+	Trace      string
+}
+
+func TestStructConverter_CrossFieldDerivation(t *testing.T) {
+	a := New()
+	a.RegisterStructConverter(scDst{}, func(dst, src interface{}) error {
+		d := dst.(*scDst)
+		s := src.(*scSrc)
+		d.QsoDateOff = d.QsoDate.Add(s.Duration)
+		return nil
+	})
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	s := scSrc{QsoDate: start, Duration: 90 * time.Minute}
+	d := scDst{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, start.Add(90*time.Minute), d.QsoDateOff)
+}
+
+func TestStructConverter_RegistrationOrder(t *testing.T) {
+	a := New()
+	a.RegisterStructConverter(&scDst{}, func(dst, src interface{}) error {
+		dst.(*scDst).Trace += "1"
+		return nil
+	})
+	a.RegisterStructConverter(scDst{}, func(dst, src interface{}) error {
+		dst.(*scDst).Trace += "2"
+		return nil
+	})
+	s := scSrc{}
+	d := scDst{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, "12", d.Trace)
+}
+
+func TestStructConverter_ErrorAborts(t *testing.T) {
+	a := New()
+	a.RegisterStructConverter(scDst{}, func(dst, src interface{}) error { return errors.New("boom") })
+	s := scSrc{}
+	d := scDst{}
+	err := a.Into(&d, &s)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "boom")
+}
+
+func TestStructConverter_NonAddressableSourceAndBuilder(t *testing.T) {
+	a := NewBuilder().AddStructConverter(scDst{}, func(dst, src interface{}) error {
+		dst.(*scDst).Trace = src.(*scSrc).Duration.String()
+		return nil
+	}).Build()
+	d := scDst{}
+	require.NoError(t, a.IntoValue(reflect.ValueOf(&d).Elem(), reflect.ValueOf(scSrc{Duration: time.Second})))
+	assert.Equal(t, "1s", d.Trace)
+}
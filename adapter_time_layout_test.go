@@ -0,0 +1,66 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type timeLayoutType struct {
+	QsoDate   string
+	QslRcvd   string
+	Confirmed string
+	Updated   string
+}
+
+type timeLayoutModel struct {
+	QsoDate   time.Time
+	QslRcvd   null.Time
+	Confirmed null.Time
+	Updated   time.Time
+}
+
+func TestTimeLayout_BothDirections(t *testing.T) {
+	a := NewWithOptions(WithTimeLayout("20060102", "2006-01-02"))
+	m := &timeLayoutModel{}
+	require.NoError(t, a.Into(m, &timeLayoutType{QsoDate: "20240315", QslRcvd: "20240401"}))
+	assert.Equal(t, time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), m.QsoDate)
+	assert.Equal(t, null.TimeFrom(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)), m.QslRcvd)
+	assert.False(t, m.Confirmed.Valid, "an empty string is an invalid null.Time")
+	assert.True(t, m.Updated.IsZero())
+
+	back := &timeLayoutType{}
+	require.NoError(t, a.Into(back, m))
+	assert.Equal(t, timeLayoutType{QsoDate: "2024-03-15", QslRcvd: "2024-04-01"}, *back)
+}
+
+func TestTimeLayout_ConverterOverrides(t *testing.T) {
+	a := NewWithOptions(WithTimeLayout("20060102", "20060102"))
+	a.RegisterConverter("Updated", func(v interface{}) (interface{}, error) {
+		return time.Unix(0, 0).UTC(), nil
+	})
+	m := &timeLayoutModel{}
+	require.NoError(t, a.Into(m, &timeLayoutType{QsoDate: "20240315", Updated: "ignored"}))
+	assert.Equal(t, time.Unix(0, 0).UTC(), m.Updated)
+	assert.Equal(t, 2024, m.QsoDate.Year())
+}
+
+func TestTimeLayout_ParseError(t *testing.T) {
+	a := NewWithOptions(WithTimeLayout("20060102", ""))
+	err := a.Into(&timeLayoutModel{}, &timeLayoutType{QsoDate: "15/03/2024"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "converter for QsoDate failed")
+
+	back := &timeLayoutType{}
+	require.NoError(t, a.Into(back, &timeLayoutModel{QsoDate: time.Now()}))
+	assert.Empty(t, back.QsoDate, "an empty format layout leaves time -> string alone")
+}
+
+func TestTimeLayout_Disabled(t *testing.T) {
+	m := &timeLayoutModel{}
+	require.NoError(t, New().Into(m, &timeLayoutType{QsoDate: "20240315"}))
+	assert.True(t, m.QsoDate.IsZero())
+}
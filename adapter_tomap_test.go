@@ -0,0 +1,75 @@
+package adapters
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type tmStation struct {
+	Operator string
+	Grid     string
+}
+
+type tmQso struct {
+	*tmStation
+	Call           string
+	ModeID         int64  `json:"mode"`
+	Secret         string `adapter:"ignore"`
+	Password       string `adapter:"writeonly"`
+	AdditionalData null.JSON
+}
+
+func TestToMap_FlattensAndConverts(t *testing.T) {
+	a := New()
+	a.RegisterConverterByJSON("mode", func(src interface{}) (interface{}, error) {
+		return map[int64]string{1: "SSB", 2: "CW"}[src.(int64)], nil
+	})
+	q := tmQso{
+		tmStation:      &tmStation{Operator: "G0ABC", Grid: "IO91"},
+		Call:           "M0XYZ",
+		ModeID:         2,
+		Secret:         "s",
+		Password:       "p",
+		AdditionalData: null.JSONFrom([]byte(`{"x":1}`)),
+	}
+	m, err := a.ToMap(&q)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"Operator": "G0ABC",
+		"Grid":     "IO91",
+		"Call":     "M0XYZ",
+		"ModeID":   "CW",
+	}, m)
+}
+
+func TestToMap_NilEmbeddedPointer(t *testing.T) {
+	a := NewWithOptions(WithIncludeZeroValues(true))
+	m, err := a.ToMap(tmQso{Call: "M0XYZ"})
+	require.NoError(t, err)
+	assert.NotContains(t, m, "Operator")
+	assert.NotContains(t, m, "Grid")
+	assert.Equal(t, "M0XYZ", m["Call"])
+	assert.Equal(t, int64(0), m["ModeID"])
+}
+
+func TestToMap_ConverterError(t *testing.T) {
+	a := New()
+	a.RegisterConverter("Call", func(src interface{}) (interface{}, error) { return nil, errors.New("boom") })
+	_, err := a.ToMap(&tmQso{Call: "x"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Call")
+}
+
+func TestToMap_InvalidInput(t *testing.T) {
+	a := New()
+	_, err := a.ToMap(nil)
+	assert.Error(t, err)
+	_, err = a.ToMap((*tmQso)(nil))
+	assert.Error(t, err)
+	_, err = a.ToMap(42)
+	assert.Error(t, err)
+}
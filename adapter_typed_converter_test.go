@@ -0,0 +1,53 @@
+package adapters
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypedConverter_Applies(t *testing.T) {
+	a := New()
+	a.RegisterConverter("Freq", TypedConverter(func(hz int64) (string, error) {
+		return strconv.FormatFloat(float64(hz)/1e6, 'f', 3, 64), nil
+	}))
+	d := fpDst{}
+	require.NoError(t, a.Into(&d, &fpSrc{Freq: 14074000}))
+	assert.Equal(t, "14.074", d.Freq)
+}
+
+func TestTypedConverter_WrongType(t *testing.T) {
+	conv := TypedConverter(func(s string) (int, error) { return len(s), nil })
+	_, err := conv(42)
+	require.EqualError(t, err, "converter expects string, got int")
+	_, err = conv(nil)
+	require.EqualError(t, err, "converter expects string, got <nil>")
+
+	a := New()
+	a.RegisterConverter("Freq", TypedConverter(func(f float64) (string, error) { return "", nil }))
+	err = a.Into(&fpDst{}, &fpSrc{Freq: 1})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "adapting field Freq")
+	assert.Contains(t, err.Error(), "converter expects float64, got int64")
+}
+
+func TestTypedConverter_NilForNilableIn(t *testing.T) {
+	conv := TypedConverter(func(p *int) (bool, error) { return p == nil, nil })
+	got, err := conv(nil)
+	require.NoError(t, err)
+	assert.Equal(t, true, got)
+
+	iconv := TypedConverter(func(v error) (string, error) { return "ok", nil })
+	got, err = iconv(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", got)
+}
+
+func TestTypedConverter_PropagatesError(t *testing.T) {
+	conv := TypedConverter(func(s string) (string, error) { return "", errors.New("bad") })
+	_, err := conv("x")
+	require.EqualError(t, err, "bad")
+}
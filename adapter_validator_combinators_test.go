@@ -0,0 +1,77 @@
+package adapters
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	errEmpty = errors.New("empty")
+	errLong  = errors.New("too long")
+	errLower = errors.New("not uppercase")
+)
+
+func notEmpty(v any) error {
+	if v.(string) == "" {
+		return errEmpty
+	}
+	return nil
+}
+
+func maxLen(n int) ValidatorFunc {
+	return func(v any) error {
+		if len(v.(string)) > n {
+			return errLong
+		}
+		return nil
+	}
+}
+
+func isUpper(v any) error {
+	if s := v.(string); s != strings.ToUpper(s) {
+		return errLower
+	}
+	return nil
+}
+
+func TestAllValidators_ShortCircuits(t *testing.T) {
+	var ran []string
+	track := func(name string, err error) ValidatorFunc {
+		return func(any) error { ran = append(ran, name); return err }
+	}
+	v := AllValidators(track("a", nil), track("b", errEmpty), track("c", nil))
+	require.ErrorIs(t, v("x"), errEmpty)
+	assert.Equal(t, []string{"a", "b"}, ran)
+
+	assert.NoError(t, AllValidators(notEmpty, maxLen(6), isUpper)("M0CMC"))
+	assert.ErrorIs(t, AllValidators(notEmpty, maxLen(6), isUpper)("m0cmc"), errLower)
+	assert.NoError(t, AllValidators()("anything"))
+}
+
+func TestAnyValidator_Aggregates(t *testing.T) {
+	v := AnyValidator(maxLen(3), isUpper)
+	assert.NoError(t, v("abc"))
+	assert.NoError(t, v("LONGCALL"))
+
+	err := v("longcall")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, errLong)
+	assert.ErrorIs(t, err, errLower)
+	assert.Equal(t, "too long\nnot uppercase", err.Error())
+
+	assert.Error(t, AnyValidator()("x"))
+}
+
+func TestValidatorCombinators_Registered(t *testing.T) {
+	a := New()
+	a.RegisterValidator("Call", AllValidators(notEmpty, AnyValidator(maxLen(6), isUpper)))
+	type S struct{ Call string }
+	require.NoError(t, a.Into(&S{}, &S{Call: "m0cmc"}))
+	require.NoError(t, a.Into(&S{}, &S{Call: "M0CMC/PORTABLE"}))
+	assert.ErrorIs(t, a.Into(&S{}, &S{}), errEmpty)
+	assert.ErrorIs(t, a.Into(&S{}, &S{Call: "m0cmc/portable"}), errLong)
+}
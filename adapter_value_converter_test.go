@@ -0,0 +1,78 @@
+package adapters
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type valueConvSrc struct {
+	Call string
+	Freq float64
+}
+
+type valueConvDst struct {
+	Call string
+	Freq int64
+}
+
+func freqToHzValue(src reflect.Value) (reflect.Value, error) {
+	return reflect.ValueOf(int64(src.Float() * 1e6)), nil
+}
+
+func TestConverterValue_Applies(t *testing.T) {
+	a := New()
+	a.RegisterConverterValue("Freq", freqToHzValue)
+	dst := &valueConvDst{}
+	require.NoError(t, a.Into(dst, &valueConvSrc{Call: "M0CMC", Freq: 14.074}))
+	assert.Equal(t, valueConvDst{Call: "M0CMC", Freq: 14074000}, *dst)
+
+	r, err := a.IntoReport(&valueConvDst{}, &valueConvSrc{Freq: 7.074})
+	require.NoError(t, err)
+	f, _ := r.Field("Freq")
+	assert.Equal(t, "value", f.Detail)
+}
+
+func TestConverterValue_Precedence(t *testing.T) {
+	a := New()
+	a.RegisterConverter("Freq", func(v interface{}) (interface{}, error) { return int64(1), nil })
+	a.RegisterConverterValue("Freq", freqToHzValue)
+	dst := &valueConvDst{}
+	require.NoError(t, a.Into(dst, &valueConvSrc{Freq: 14.074}))
+	assert.Equal(t, int64(14074000), dst.Freq, "wins over a plain global converter")
+
+	a.RegisterConverterFor(valueConvDst{}, "Freq", func(v interface{}) (interface{}, error) { return int64(2), nil })
+	require.NoError(t, a.Into(dst, &valueConvSrc{Freq: 14.074}))
+	assert.Equal(t, int64(2), dst.Freq, "destination-scoped converters win over it")
+}
+
+func TestConverterValue_ZeroAndErrors(t *testing.T) {
+	a := New()
+	a.RegisterConverterValue("Freq", func(src reflect.Value) (reflect.Value, error) {
+		switch {
+		case src.Float() == 0:
+			return reflect.Value{}, nil
+		case src.Float() < 0:
+			return reflect.Value{}, errors.New("negative")
+		}
+		return reflect.ValueOf(strconv.FormatFloat(src.Float(), 'f', -1, 64)), nil
+	})
+	dst := &valueConvDst{Freq: 5}
+	require.NoError(t, a.Into(dst, &valueConvSrc{}))
+	assert.Zero(t, dst.Freq, "an invalid Value resets the field")
+
+	err := a.Into(dst, &valueConvSrc{Freq: -1})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "converter for Freq failed on -1 (float64): negative")
+
+	err = a.Into(dst, &valueConvSrc{Freq: 14.074})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "converter returned type string, expected int64")
+	var ae *AdaptError
+	require.ErrorAs(t, err, &ae)
+	assert.False(t, ae.Recoverable())
+}
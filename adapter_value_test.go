@@ -0,0 +1,59 @@
+package adapters
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntoValue_AddressableStructs(t *testing.T) {
+	a := New()
+	type S struct{ Name string }
+	type D struct{ Name string }
+	s := S{Name: "x"}
+	d := D{}
+	require.NoError(t, a.IntoValue(reflect.ValueOf(&d).Elem(), reflect.ValueOf(s)))
+	assert.Equal(t, "x", d.Name)
+}
+
+func TestIntoValue_PointerValues(t *testing.T) {
+	a := New()
+	type S struct{ Name string }
+	type D struct{ Name string }
+	s := S{Name: "y"}
+	d := D{}
+	require.NoError(t, a.IntoValue(reflect.ValueOf(&d), reflect.ValueOf(&s)))
+	assert.Equal(t, "y", d.Name)
+}
+
+func TestIntoValue_NestedFromConverter(t *testing.T) {
+	a := New()
+	type SIn struct{ V int }
+	type DIn struct{ V int }
+	type S struct{ Inner SIn }
+	type D struct{ Inner DIn }
+	a.RegisterConverter("Inner", func(v any) (any, error) {
+		var out DIn
+		if err := a.IntoValue(reflect.ValueOf(&out).Elem(), reflect.ValueOf(v)); err != nil {
+			return nil, err
+		}
+		return out, nil
+	})
+	s := S{Inner: SIn{V: 7}}
+	d := D{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, 7, d.Inner.V)
+}
+
+func TestIntoValue_Errors(t *testing.T) {
+	a := New()
+	type S struct{ Name string }
+	type D struct{ Name string }
+	assert.Error(t, a.IntoValue(reflect.Value{}, reflect.ValueOf(S{})))
+	assert.Error(t, a.IntoValue(reflect.ValueOf(D{}), reflect.ValueOf(S{}))) // not settable
+	assert.Error(t, a.IntoValue(reflect.ValueOf((*D)(nil)), reflect.ValueOf(S{})))
+	n := 1
+	assert.Error(t, a.IntoValue(reflect.ValueOf(&n).Elem(), reflect.ValueOf(S{})))
+}
@@ -0,0 +1,75 @@
+package adapters
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type woUser struct {
+	Name         string
+	Rig          string
+	PasswordHash string `json:"password_hash" adapter:"writeonly"`
+}
+
+type woRecord struct {
+	Name           string
+	AdditionalData null.JSON
+}
+
+func TestWriteonly_WrittenAsDestination(t *testing.T) {
+	a := New()
+	type Input struct {
+		Name         string
+		PasswordHash string `json:"password_hash"`
+	}
+	s := Input{Name: "op", PasswordHash: "h"}
+	d := woUser{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, woUser{Name: "op", PasswordHash: "h"}, d)
+}
+
+func TestWriteonly_NotReadAsSource(t *testing.T) {
+	a := New()
+	type Output struct {
+		Name         string
+		PasswordHash string `json:"password_hash"`
+	}
+	s := woUser{Name: "op", PasswordHash: "h"}
+	d := Output{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, Output{Name: "op"}, d)
+}
+
+func TestWriteonly_NeverMarshaledIntoAdditionalData(t *testing.T) {
+	a := New()
+	s := woUser{Name: "op", Rig: "IC-7300", PasswordHash: "h"}
+	d := woRecord{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, "op", d.Name)
+	require.True(t, d.AdditionalData.Valid)
+	var m map[string]any
+	require.NoError(t, json.Unmarshal(d.AdditionalData.JSON, &m))
+	assert.Equal(t, map[string]any{"Rig": "IC-7300"}, m)
+}
+
+func TestWriteonly_UnmarshaledFromAdditionalData(t *testing.T) {
+	a := New()
+	raw, err := json.Marshal(map[string]any{"password_hash": "h"})
+	require.NoError(t, err)
+	s := woRecord{Name: "op", AdditionalData: null.JSONFrom(raw)}
+	d := woUser{}
+	require.NoError(t, a.Into(&d, &s))
+	assert.Equal(t, woUser{Name: "op", PasswordHash: "h"}, d)
+}
+
+func TestWriteonly_CheckMapping(t *testing.T) {
+	a := New()
+	r := a.CheckMapping(woUser{}, woRecord{})
+	require.NoError(t, r.Err)
+	assert.Equal(t, []string{"Rig"}, r.ToAdditionalData)
+	assert.Empty(t, r.SourceOnly)
+}
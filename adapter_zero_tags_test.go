@@ -0,0 +1,60 @@
+package adapters
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type zeroTagSrc struct {
+	Call     string
+	Count    int    `adapter:"includezero"`
+	Comment  string `adapter:"omitzero"`
+	Operator string
+}
+
+type zeroTagDst struct {
+	Call           string
+	AdditionalData null.JSON
+}
+
+func TestZeroTags_IncludeZero(t *testing.T) {
+	dst := &zeroTagDst{}
+	require.NoError(t, New().Into(dst, &zeroTagSrc{Call: "M0CMC"}))
+	assert.JSONEq(t, `{"Count":0}`, string(dst.AdditionalData.JSON), "only the includezero field is kept when zero")
+}
+
+func TestZeroTags_OmitZero(t *testing.T) {
+	dst := &zeroTagDst{}
+	require.NoError(t, NewWithOptions(WithIncludeZeroValues(true)).Into(dst, &zeroTagSrc{Call: "M0CMC"}))
+	assert.JSONEq(t, `{"Count":0,"Operator":""}`, string(dst.AdditionalData.JSON), "the omitzero field is left out when zero")
+}
+
+func TestZeroTags_NonZeroAlwaysMarshaled(t *testing.T) {
+	dst := &zeroTagDst{}
+	require.NoError(t, NewWithOptions(WithIncludeZeroValues(true)).Into(dst, &zeroTagSrc{Count: 3, Comment: "tnx"}))
+	assert.JSONEq(t, `{"Count":3,"Comment":"tnx","Operator":""}`, string(dst.AdditionalData.JSON))
+}
+
+func TestZeroTags_DirectCopyUnaffected(t *testing.T) {
+	type dst struct {
+		Count   int
+		Comment string
+	}
+	d := &dst{Count: 9, Comment: "old"}
+	require.NoError(t, New().Into(d, &zeroTagSrc{}))
+	assert.Equal(t, dst{}, *d, "the tags only concern AdditionalData; matched fields are still copied")
+}
+
+func TestZeroTags_ToMap(t *testing.T) {
+	m, err := New().ToMap(zeroTagSrc{Call: "M0CMC"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"Call": "M0CMC", "Count": 0}, m)
+}
+
+func TestZeroTags_Lint(t *testing.T) {
+	assert.Empty(t, CheckStruct(reflect.TypeOf(zeroTagSrc{})))
+}
@@ -0,0 +1,53 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+)
+
+// AdaptError is the error Into (and everything built on it) returns when adapting a struct fails, other
+// than for invalid arguments, context cancellation and a malformed top-level struct type (which returns the
+// type's cached build error itself; treat it as fatal). Its message is that of the underlying error; use
+// errors.As to reach it through the wrapping added by the bulk helpers.
+//
+// Failures are classified so batch jobs can skip bad rows but abort on wiring mistakes:
+//
+//   - Recoverable (bad data): a converter returning an error, a validator rejecting a value, invalid or
+//     non-object source AdditionalData, a preprocessor or AdditionalData converter error, a struct converter
+//     error, malformed JSON in a JSON text field.
+//   - Fatal (programmer error): a converter returning a value of the wrong type, a malformed nested struct
+//     type (e.g. duplicate json names), fields dropped or left incompatible under ErrorOnUnmappedSource, and
+//     values that cannot be marshaled into AdditionalData.
+//
+// A failure in a nested struct or slice element keeps its category.
+type AdaptError struct {
+	Field string // destination field being adapted (dotted for field groups), "" for struct-level failures
+	Err   error  // the underlying error
+	fatal bool
+}
+
+func (e *AdaptError) Error() string { return e.Err.Error() }
+func (e *AdaptError) Unwrap() error { return e.Err }
+
+// Recoverable reports whether the failure is caused by the data being adapted, so skipping the record and
+// carrying on is safe, rather than by the adapter's configuration or the types involved.
+func (e *AdaptError) Recoverable() bool { return !e.fatal }
+
+// fatalError marks an error as a programmer error for AdaptError classification; it is transparent otherwise.
+type fatalError struct{ err error }
+
+func (e *fatalError) Error() string { return e.err.Error() }
+func (e *fatalError) Unwrap() error { return e.err }
+
+// fatal marks err as a programmer error.
+func fatal(err error) error { return &fatalError{err} }
+
+// adaptError wraps err into an AdaptError for field, classifying it by whether its chain holds a fatalError.
+// Context errors are returned unchanged.
+func adaptError(field string, err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	var fe *fatalError
+	return &AdaptError{Field: field, Err: err, fatal: errors.As(err, &fe)}
+}
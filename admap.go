@@ -0,0 +1,49 @@
+package adapters
+
+import (
+	"fmt"
+
+	"github.com/aarondl/null/v8"
+	boilertypes "github.com/aarondl/sqlboiler/v4/types"
+	"github.com/goccy/go-json"
+)
+
+// AdditionalDataMap decodes an AdditionalData value into a map. v may be a null.JSON, a sqlboiler types.JSON,
+// a json.RawMessage or a []byte, or a pointer to a null.JSON or types.JSON. A nil pointer, an invalid null.JSON,
+// empty bytes and the JSON literal null yield a nil map; any other JSON that is not an object is an error.
+func AdditionalDataMap(v interface{}) (map[string]interface{}, error) {
+	var raw []byte
+	switch x := v.(type) {
+	case null.JSON:
+		if !x.Valid {
+			return nil, nil
+		}
+		raw = x.JSON
+	case *null.JSON:
+		if x == nil || !x.Valid {
+			return nil, nil
+		}
+		raw = x.JSON
+	case boilertypes.JSON:
+		raw = x
+	case *boilertypes.JSON:
+		if x == nil {
+			return nil, nil
+		}
+		raw = *x
+	case json.RawMessage:
+		raw = x
+	case []byte:
+		raw = x
+	default:
+		return nil, fmt.Errorf("unsupported AdditionalData type %T", v)
+	}
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
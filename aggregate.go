@@ -0,0 +1,49 @@
+package adapters
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// isArrayPair reports whether src/dst are an array/array, array/slice or slice/array combination.
+func isArrayPair(src, dst reflect.Type) bool {
+	sk, dk := src.Kind(), dst.Kind()
+	return (sk == reflect.Array && (dk == reflect.Array || dk == reflect.Slice)) ||
+		(sk == reflect.Slice && dk == reflect.Array)
+}
+
+// assignAggregate copies src into dst element-wise when the element types are assignable or convertible
+// without changing kind (see convertibleKind). It returns false (without touching dst) when the element types
+// are incompatible, and an error when a fixed-size destination cannot hold exactly the source elements.
+func assignAggregate(dstField, srcField reflect.Value) (bool, error) {
+	st, dt := srcField.Type(), dstField.Type()
+	se, de := st.Elem(), dt.Elem()
+	assignable := se.AssignableTo(de)
+	if !assignable && !convertibleKind(se, de) {
+		return false, nil
+	}
+	n := srcField.Len()
+	var out reflect.Value
+	if dt.Kind() == reflect.Array {
+		if srcField.Kind() == reflect.Slice && srcField.IsNil() {
+			dstField.Set(reflect.Zero(dt))
+			return true, nil
+		}
+		if n != dt.Len() {
+			return false, fmt.Errorf("length mismatch: cannot copy %d elements of %s into %s", n, st, dt)
+		}
+		out = reflect.New(dt).Elem()
+	} else {
+		out = reflect.MakeSlice(dt, n, n)
+	}
+	for i := 0; i < n; i++ {
+		e := srcField.Index(i)
+		if assignable {
+			out.Index(i).Set(e)
+		} else {
+			out.Index(i).Set(e.Convert(de))
+		}
+	}
+	dstField.Set(out)
+	return true, nil
+}
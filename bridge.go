@@ -0,0 +1,183 @@
+package adapters
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+// fieldBridge routes an unexported field through user-provided accessors.
+type fieldBridge struct {
+	get func(interface{}) interface{}
+	set func(interface{}, interface{})
+}
+
+// RegisterUnexportedBridge lets the adapter read and/or write an unexported field of typ (an example value
+// or pointer) through accessor funcs instead of reflection, which cannot set unexported fields. get receives
+// a pointer to the struct and returns the field value; set receives a pointer to the struct and the value to
+// store. Either may be nil to make the bridge read-only or write-only.
+//
+// A bridged field matches a field of the same name on the other side of an adaptation, or its exported
+// spelling (state <-> State). Pair, destination and global converters registered under the destination
+// field name apply; set receives the converted value as-is, so it must type-assert it itself.
+//
+// Safety: the adapter cannot check what the accessors do. They run on every adaptation of the type, possibly
+// concurrently, and get is passed a pointer to a temporary copy when the source is not addressable, so
+// neither func may retain the pointer. Bridging third-party internals ties you to their layout.
+func (a *Adapter) RegisterUnexportedBridge(typ any, fieldName string, get func(interface{}) interface{}, set func(interface{}, interface{})) error {
+	t := reflect.TypeOf(typ)
+	if t == nil {
+		return fmt.Errorf("bridge type must not be nil")
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return fmt.Errorf("bridge type %s must be a struct", t)
+	}
+	f, ok := t.FieldByName(fieldName)
+	if !ok || len(f.Index) != 1 {
+		return fmt.Errorf("bridge type %s has no field %s", t, fieldName)
+	}
+	if f.PkgPath == "" {
+		return fmt.Errorf("field %s of %s is exported and needs no bridge", fieldName, t)
+	}
+	if get == nil && set == nil {
+		return fmt.Errorf("bridge for %s.%s needs a getter or a setter", t, fieldName)
+	}
+	newReg := a.converters.Load().(*converterRegistry).clone()
+	m := newReg.bridge[t]
+	if m == nil {
+		m = make(map[string]fieldBridge)
+		newReg.bridge[t] = m
+	}
+	m[fieldName] = fieldBridge{get: get, set: set}
+	a.converters.Store(newReg)
+	a.gen.Add(1)
+	return nil
+}
+
+// exportedName upper-cases the first letter of name.
+func exportedName(name string) string {
+	r, n := utf8.DecodeRuneInString(name)
+	return string(unicode.ToUpper(r)) + name[n:]
+}
+
+// planBridges appends plan entries for bridged fields: bridged destination fields fed by an exported or
+// bridged source field, and exported destination fields fed by a bridged source field.
+func (a *Adapter) planBridges(p *buildPlan, reg *converterRegistry, vreg *validatorRegistry, srcMeta, dstMeta *structMetadata) {
+	st, dt := p.srcType, p.dstType
+	srcBridges, dstBridges := reg.bridge[st], reg.bridge[dt]
+	resolve := func(name string) (ConverterFunc, ValidatorFunc) {
+		conv := reg.byPair[[2]reflect.Type{st, dt}][name]
+		if conv == nil {
+			conv = reg.byDst[dt][name]
+		}
+		if conv == nil {
+			conv = reg.global[name]
+		}
+		val := vreg.byPair[[2]reflect.Type{st, dt}][name]
+		if val == nil {
+			val = vreg.byDst[dt][name]
+		}
+		if val == nil {
+			val = vreg.global[name]
+		}
+		return conv, val
+	}
+	// bridged destination fields, in name order so plans are deterministic
+	for _, name := range sortedKeys(dstBridges) {
+		db := dstBridges[name]
+		if db.set == nil || a.skipDst(name) {
+			continue
+		}
+		fp := fieldPlan{_dstName: name, set: db.set}
+		if sb, ok := srcBridges[name]; ok && sb.get != nil {
+			fp._srcName, fp.get = name, sb.get
+		} else if sf, ok := srcMeta.fieldsByName[exportedName(name)]; ok && !sf.isAdditionalData && !sf.ignore && !sf.writeonly {
+			fp._srcName, fp._srcIndex = sf.name, sf.index
+		} else {
+			continue
+		}
+		fp.conv, fp.val = resolve(name)
+		p.fields = append(p.fields, fp)
+	}
+	// exported destination fields fed by a bridged source field
+	for _, name := range sortedKeys(srcBridges) {
+		sb := srcBridges[name]
+		if sb.get == nil {
+			continue
+		}
+		if db, ok := dstBridges[name]; ok && db.set != nil {
+			continue // handled above
+		}
+		df, ok := dstMeta.fieldsByName[exportedName(name)]
+		if !ok || !df.canSet || df.isAdditionalData || df.ignore || df.readonly || a.skipDst(df.name) {
+			continue
+		}
+		fp := fieldPlan{_srcName: name, _dstName: df.name, _dstIndex: df.index, get: sb.get}
+		fp.conv, fp.val = resolve(df.name)
+		p.fields = append(p.fields, fp)
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// runBridge executes a plan entry that reads and/or writes through bridge accessors.
+func (a *Adapter) runBridge(fp *fieldPlan, dstVal, srcVal reflect.Value) error {
+	var value interface{}
+	if fp.get != nil {
+		value = fp.get(addrOf(srcVal).Interface())
+	} else {
+		srcField, ok := a.safeFieldByIndex(srcVal, fp._srcIndex)
+		if !ok {
+			return nil
+		}
+		value = srcField.Interface()
+	}
+	if fp.conv != nil {
+		var err error
+		if value, err = fp.conv(value); err != nil {
+			return err
+		}
+	}
+	if fp.set != nil {
+		if fp.val != nil {
+			if err := fp.val(value); err != nil {
+				return err
+			}
+		}
+		fp.set(dstVal.Addr().Interface(), value)
+		return nil
+	}
+	dstField, ok := a.fieldByIndexAlloc(dstVal, fp._dstIndex)
+	if !ok {
+		return nil
+	}
+	if value == nil {
+		dstField.Set(reflect.Zero(dstField.Type()))
+	} else {
+		cv := reflect.ValueOf(value)
+		switch {
+		case cv.Type().AssignableTo(dstField.Type()):
+			dstField.Set(cv)
+		case cv.Type().ConvertibleTo(dstField.Type()):
+			dstField.Set(cv.Convert(dstField.Type()))
+		default:
+			return fmt.Errorf("bridge returned type %s, expected %s", cv.Type(), dstField.Type())
+		}
+	}
+	if fp.val != nil {
+		return fp.val(dstField.Interface())
+	}
+	return nil
+}
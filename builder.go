@@ -1,6 +1,11 @@
 package adapters
 
-import "reflect"
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
 
 // Builder provides a fluent API to construct an Adapter with options, converters and validators pre-registered.
 type Builder struct {
@@ -8,9 +13,13 @@ type Builder struct {
 	convsG   map[string]ConverterFunc
 	convsDst map[reflect.Type]map[string]ConverterFunc
 	convsP   map[[2]reflect.Type]map[string]ConverterFunc
+	convsJ   map[string]ConverterFunc
 	valsG    map[string]ValidatorFunc
 	valsDst  map[reflect.Type]map[string]ValidatorFunc
 	valsP    map[[2]reflect.Type]map[string]ValidatorFunc
+	structs  map[reflect.Type][]StructConverterFunc
+	warm     []any
+	warmP    [][2]any
 }
 
 // NewBuilder creates a new builder.
@@ -19,12 +28,24 @@ func NewBuilder() *Builder {
 		convsG:   make(map[string]ConverterFunc),
 		convsDst: make(map[reflect.Type]map[string]ConverterFunc),
 		convsP:   make(map[[2]reflect.Type]map[string]ConverterFunc),
+		convsJ:   make(map[string]ConverterFunc),
 		valsG:    make(map[string]ValidatorFunc),
 		valsDst:  make(map[reflect.Type]map[string]ValidatorFunc),
 		valsP:    make(map[[2]reflect.Type]map[string]ValidatorFunc),
+		structs:  make(map[reflect.Type][]StructConverterFunc),
 	}
 }
 
+// Warm records example values or types whose metadata Build pre-builds (see WarmMetadata).
+func (b *Builder) Warm(examples ...any) *Builder { b.warm = append(b.warm, examples...); return b }
+
+// WarmPair records a (src,dst) pair whose plan Build pre-builds once all registrations are in place (see
+// Adapter.WarmPair).
+func (b *Builder) WarmPair(src, dst any) *Builder {
+	b.warmP = append(b.warmP, [2]any{src, dst})
+	return b
+}
+
 // WithOptions appends adapter options to the builder.
 func (b *Builder) WithOptions(opts ...Option) *Builder { b.opts = append(b.opts, opts...); return b }
 
@@ -69,6 +90,22 @@ func (b *Builder) AddConverterForPair(src, dst any, field string, fn ConverterFu
 	return b
 }
 
+// AddConverterByJSON registers a converter keyed by json tag name.
+func (b *Builder) AddConverterByJSON(jsonName string, fn ConverterFunc) *Builder {
+	b.convsJ[jsonName] = fn
+	return b
+}
+
+// AddStructConverter registers a whole-struct converter for a destination type (run in registration order).
+func (b *Builder) AddStructConverter(dst any, fn StructConverterFunc) *Builder {
+	dt := reflect.TypeOf(dst)
+	if dt.Kind() == reflect.Ptr {
+		dt = dt.Elem()
+	}
+	b.structs[dt] = append(b.structs[dt], fn)
+	return b
+}
+
 // AddValidator registers a global validator by field name.
 func (b *Builder) AddValidator(field string, fn ValidatorFunc) *Builder {
 	b.valsG[field] = fn
@@ -114,10 +151,13 @@ func (b *Builder) AddValidatorForPair(src, dst any, field string, fn ValidatorFu
 func (b *Builder) Build() *Adapter {
 	a := NewWithOptions(b.opts...)
 	// Seed registries in one shot to avoid many copy-on-write swaps.
-	creg := &converterRegistry{global: make(map[string]ConverterFunc, len(b.convsG)), byDst: make(map[reflect.Type]map[string]ConverterFunc, len(b.convsDst)), byPair: make(map[[2]reflect.Type]map[string]ConverterFunc, len(b.convsP))}
+	creg := &converterRegistry{global: make(map[string]ConverterFunc, len(b.convsG)), byDst: make(map[reflect.Type]map[string]ConverterFunc, len(b.convsDst)), byPair: make(map[[2]reflect.Type]map[string]ConverterFunc, len(b.convsP)), byJSON: make(map[string]ConverterFunc, len(b.convsJ)), enums: make(map[string]*enumMapping), toAD: make(map[string]MarshalTransformFunc), adConv: make(map[string]ConverterFunc)}
 	for k, v := range b.convsG {
 		creg.global[k] = v
 	}
+	for k, v := range b.convsJ {
+		creg.byJSON[k] = v
+	}
 	for t, m := range b.convsDst {
 		sub := make(map[string]ConverterFunc, len(m))
 		for k, v := range m {
@@ -152,5 +192,82 @@ func (b *Builder) Build() *Adapter {
 		vreg.byPair[k] = sub
 	}
 	a.validators.Store(vreg)
+	sreg := make(map[reflect.Type][]StructConverterFunc, len(b.structs))
+	for t, fns := range b.structs {
+		sreg[t] = append([]StructConverterFunc(nil), fns...)
+	}
+	a.structConvs.Store(sreg)
+	a.WarmMetadata(b.warm...)
+	for _, p := range b.warmP {
+		a.WarmPair(p[0], p[1])
+	}
 	return a
 }
+
+// BuildChecked is Build plus a check that every converter and validator scoped to a destination type (by
+// AddConverterFor/AddValidatorFor or their pair variants) names a field of that type, by its Go name as
+// matched at adapt time (fields of embedded and flattened structs included). Globally and json-scoped
+// registrations cannot be checked. All problems are joined into one error; the adapter is returned either way.
+func (b *Builder) BuildChecked() (*Adapter, error) {
+	a := b.Build()
+	var errs []error
+	check := func(kind string, dt reflect.Type, fields []string) {
+		meta := a.getOrBuildMetadata(dt)
+		if err := metadataErr(meta); err != nil {
+			errs = append(errs, fmt.Errorf("%s for %s: %w", kind, dt, err))
+			return
+		}
+		for _, f := range fields {
+			if meta.fieldsByName[f] == nil {
+				errs = append(errs, fmt.Errorf("%s for %s: no field %s", kind, dt, f))
+			}
+		}
+	}
+	for _, dt := range sortedTypes(b.convsDst) {
+		check("converter", dt, sortedKeys(b.convsDst[dt]))
+	}
+	for _, k := range sortedPairs(b.convsP) {
+		check("converter", k[1], sortedKeys(b.convsP[k]))
+	}
+	for _, dt := range sortedTypes(b.valsDst) {
+		check("validator", dt, sortedKeys(b.valsDst[dt]))
+	}
+	for _, k := range sortedPairs(b.valsP) {
+		check("validator", k[1], sortedKeys(b.valsP[k]))
+	}
+	return a, errors.Join(errs...)
+}
+
+// MustBuild is BuildChecked that panics on a configuration error, for wiring done at program startup.
+func (b *Builder) MustBuild() *Adapter {
+	a, err := b.BuildChecked()
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// sortedTypes returns the keys of m ordered by type name, for deterministic error output.
+func sortedTypes[V any](m map[reflect.Type]V) []reflect.Type {
+	out := make([]reflect.Type, 0, len(m))
+	for t := range m {
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].String() < out[j].String() })
+	return out
+}
+
+// sortedPairs returns the keys of m ordered by source then destination type name.
+func sortedPairs[V any](m map[[2]reflect.Type]V) [][2]reflect.Type {
+	out := make([][2]reflect.Type, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i][0] != out[j][0] {
+			return out[i][0].String() < out[j][0].String()
+		}
+		return out[i][1].String() < out[j][1].String()
+	})
+	return out
+}
@@ -0,0 +1,19 @@
+package adapters
+
+import (
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// MapStringUpper returns a ConverterFunc upper-casing string values with the casing rules of lang, e.g.
+// language.Turkish maps i to İ instead of I, avoiding the Turkish-I bug with names and callsign suffixes.
+// Non-string values are returned unchanged. Keep MapString(strings.ToUpper) for ASCII data.
+func MapStringUpper(lang language.Tag) ConverterFunc {
+	// A cases.Caser keeps state between calls, so each conversion gets its own to stay safe for concurrent use.
+	return MapString(func(s string) string { return cases.Upper(lang).String(s) })
+}
+
+// MapStringLower is MapStringUpper for lower case: in Turkish I becomes ı, and Greek final sigma is applied.
+func MapStringLower(lang language.Tag) ConverterFunc {
+	return MapString(func(s string) string { return cases.Lower(lang).String(s) })
+}
@@ -0,0 +1,66 @@
+package adapters
+
+import "reflect"
+
+// RegisterCoalesce fills the destination field dstField from the first of srcFields (Go names, tried in order)
+// whose value is not the zero value, e.g. Email from WorkEmail or HomeEmail. When all of them are zero the
+// first one is used, so the destination is written with its zero value like any other matched field. The
+// destination field's converter and validator apply to the chosen value; they resolve by dstField's name as
+// usual. The entry replaces dstField's regular name match. Only the chosen source field counts as processed:
+// candidates that were passed over stay unmatched and may be marshaled into destination AdditionalData.
+// Unknown, ignored, writeonly and AdditionalData source fields are skipped; if none remain, dstField keeps its
+// regular match. Plans with a coalesced field never run in parallel. Registering dstField again replaces its
+// candidates.
+func (a *Adapter) RegisterCoalesce(dstField string, srcFields ...string) {
+	newReg := a.converters.Load().(*converterRegistry).clone()
+	newReg.coalesce[dstField] = append([]string(nil), srcFields...)
+	a.converters.Store(newReg)
+	a.gen.Add(1)
+}
+
+// planCoalesce adds or replaces plan entries for coalesced destination fields. The first usable candidate
+// becomes the entry itself and the others its alts.
+func (a *Adapter) planCoalesce(p *buildPlan, reg *converterRegistry, vreg *validatorRegistry, srcMeta, dstMeta *structMetadata) {
+	for _, dstName := range sortedKeys(reg.coalesce) {
+		df := dstMeta.fieldsByName[dstName]
+		if df == nil || !df.canSet || df.isAdditionalData || df.ignore || df.readonly || a.skipDst(df.name) {
+			continue
+		}
+		var candidates []fieldPlan
+		for _, srcName := range reg.coalesce[dstName] {
+			sf := srcMeta.fieldsByName[srcName]
+			if sf == nil || sf.isAdditionalData || sf.ignore || sf.writeonly {
+				continue
+			}
+			candidates = append(candidates, a.planField(reg, vreg, p.srcType, p.dstType, df, sf))
+		}
+		if len(candidates) == 0 {
+			continue
+		}
+		fp := candidates[0]
+		fp.alts = candidates[1:]
+		replaced := false
+		for i := range p.fields {
+			if p.fields[i]._dstName == dstName {
+				p.fields[i], replaced = fp, true
+				break
+			}
+		}
+		if !replaced {
+			p.fields = append(p.fields, fp)
+		}
+	}
+}
+
+// coalesced returns the candidate of fp whose source value is set, or fp itself when none is.
+func (a *Adapter) coalesced(fp *fieldPlan, srcVal reflect.Value) *fieldPlan {
+	if v, ok := a.safeFieldByIndex(srcVal, fp._srcIndex); ok && !v.IsZero() {
+		return fp
+	}
+	for i := range fp.alts {
+		if v, ok := a.safeFieldByIndex(srcVal, fp.alts[i]._srcIndex); ok && !v.IsZero() {
+			return &fp.alts[i]
+		}
+	}
+	return fp
+}
@@ -0,0 +1,65 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+)
+
+// Compile resolves metadata and the field plan for one src/dst type pair up front and returns a function
+// that adapts values of exactly those types, skipping the per-call type checks and cache lookups done by
+// Into. src and dst are example values or pointers; the returned function must be called with pointers
+// to the compiled types, otherwise it returns an error.
+//
+// The compiled function is safe for concurrent use. If converters or validators are registered after
+// compilation the plan is re-resolved on the next call, so results always match Into.
+func (a *Adapter) Compile(src, dst any) (func(dst, src interface{}) error, error) {
+	st, dt := reflect.TypeOf(src), reflect.TypeOf(dst)
+	if st == nil || dt == nil {
+		return nil, fmt.Errorf("src and dst must not be nil")
+	}
+	if st.Kind() == reflect.Ptr {
+		st = st.Elem()
+	}
+	if dt.Kind() == reflect.Ptr {
+		dt = dt.Elem()
+	}
+	if st.Kind() != reflect.Struct || dt.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("src and dst must be structs")
+	}
+	srcMeta := a.getOrBuildMetadata(st)
+	dstMeta := a.getOrBuildMetadata(dt)
+	if err := metadataErr(dstMeta, srcMeta); err != nil {
+		return nil, err
+	}
+	srcPtrType, dstPtrType := reflect.PointerTo(st), reflect.PointerTo(dt)
+	var plan atomic.Pointer[buildPlan]
+	plan.Store(a.getPlan(st, dt))
+	return func(d, s interface{}) error {
+		dstVal, srcVal := reflect.ValueOf(d), reflect.ValueOf(s)
+		if !dstVal.IsValid() || !srcVal.IsValid() {
+			return fmt.Errorf("src and dst must not be nil")
+		}
+		if dstVal.Type() != dstPtrType || srcVal.Type() != srcPtrType {
+			return fmt.Errorf("compiled for %s -> %s, got %T -> %T", srcPtrType, dstPtrType, s, d)
+		}
+		if dstVal.IsNil() || srcVal.IsNil() {
+			return fmt.Errorf("src and dst must not be nil")
+		}
+		p := plan.Load()
+		if p.gen != a.gen.Load() {
+			p = a.getPlan(st, dt)
+			plan.Store(p)
+		}
+		return a.runPlan(context.Background(), dstVal.Elem(), srcVal.Elem(), p, dstMeta, srcMeta)
+	}, nil
+}
+
+// Generation returns the adapter's registry generation. It starts at 1 and increments on every registration
+// that can change a plan: converters of any scope, validators, struct converters, enums, bridges, fanouts,
+// field groups and coalesced fields (there is no removal API). RegisterFactory and RegisterNullType do not
+// change it. Callers caching their own derived state, such as a map of functions from Compile keyed by type
+// pair, can store the generation alongside and rebuild when it differs. Functions returned by Compile already
+// re-resolve their plan on their own, so they stay correct either way.
+func (a *Adapter) Generation() uint64 { return a.gen.Load() }
@@ -0,0 +1,61 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// ContextConverterFunc is a converter that receives the adaptation context, for converters that call out
+// to external lookups and should honor cancellation and deadlines.
+type ContextConverterFunc func(ctx context.Context, src interface{}) (interface{}, error)
+
+// RegisterContextConverter adds a global context-aware converter for fieldName. It takes precedence over a
+// plain global converter for the same field; destination- and pair-scoped converters still win over it.
+// Outside IntoCtx/IntoTimeout it is called with context.Background().
+func (a *Adapter) RegisterContextConverter(fieldName string, fn ContextConverterFunc) {
+	newReg := a.converters.Load().(*converterRegistry).clone()
+	newReg.ctx[fieldName] = fn
+	a.converters.Store(newReg)
+	a.gen.Add(1)
+}
+
+// IntoCtx is Into with a context passed to context-aware converters. The context is checked before each
+// context-aware converter runs; once it is done adaptation stops and ctx.Err() is returned, leaving dst
+// partially adapted. Plain converters and direct copies are not interrupted.
+func (a *Adapter) IntoCtx(ctx context.Context, dst, src interface{}) error {
+	if ctx == nil {
+		return fmt.Errorf("ctx must not be nil")
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if src == nil || dst == nil {
+		return fmt.Errorf("src and dst must not be nil")
+	}
+	srcVal := reflect.ValueOf(src)
+	dstVal := reflect.ValueOf(dst)
+	if srcVal.Kind() != reflect.Ptr || dstVal.Kind() != reflect.Ptr {
+		return fmt.Errorf("src and dst must be pointers")
+	}
+	inPlace := srcVal.Pointer() == dstVal.Pointer() && srcVal.Type() == dstVal.Type()
+	srcVal = srcVal.Elem()
+	dstVal = dstVal.Elem()
+	if srcVal.Kind() != reflect.Struct || dstVal.Kind() != reflect.Struct {
+		return fmt.Errorf("src and dst must point to structs")
+	}
+	if inPlace {
+		return a.adaptInPlace(ctx, dstVal)
+	}
+	return a.adaptStructCtx(ctx, dstVal, srcVal)
+}
+
+// IntoTimeout runs IntoCtx with a context that expires after d, returning context.DeadlineExceeded if
+// the bound is hit. Only context-aware converters observe the deadline: a slow plain converter, or a
+// context-aware one that ignores its ctx, still runs to completion.
+func (a *Adapter) IntoTimeout(d time.Duration, dst, src interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+	return a.IntoCtx(ctx, dst, src)
+}
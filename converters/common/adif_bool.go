@@ -0,0 +1,79 @@
+package common
+
+import (
+	"github.com/Station-Manager/adapters/converters"
+	"github.com/Station-Manager/errors"
+	"github.com/aarondl/null/v8"
+	"strings"
+)
+
+// TypeToModelADIFBoolConverter converts an ADIF boolean string (QSL sent/received flags and the like) to a
+// bool: "Y" is true, "N" and "" are false, case-insensitively and ignoring surrounding spaces. Anything else
+// is an error. Use NewTypeToModelADIFBoolConverter(true) for a null.Bool field where "" means unknown.
+func TypeToModelADIFBoolConverter(src any) (any, error) {
+	const op errors.Op = "converters.common.TypeToModelADIFBoolConverter"
+	b, _, err := parseADIFBool(op, src)
+	if err != nil {
+		return false, err
+	}
+	return b, nil
+}
+
+// NewTypeToModelADIFBoolConverter returns an ADIF boolean converter like TypeToModelADIFBoolConverter. With
+// emptyAsNull it returns a null.Bool instead, invalid (null) for an empty string, for nullable model columns.
+func NewTypeToModelADIFBoolConverter(emptyAsNull bool) func(any) (any, error) {
+	if !emptyAsNull {
+		return TypeToModelADIFBoolConverter
+	}
+	return func(src any) (any, error) {
+		const op errors.Op = "converters.common.TypeToModelADIFBoolConverter"
+		b, empty, err := parseADIFBool(op, src)
+		if err != nil {
+			return null.Bool{}, err
+		}
+		if empty {
+			return null.Bool{}, nil
+		}
+		return null.BoolFrom(b), nil
+	}
+}
+
+// ModelToTypeADIFBoolConverter converts a model bool or null.Bool to an ADIF boolean string: true is "Y" and
+// false is "N". An invalid (null) null.Bool becomes "".
+func ModelToTypeADIFBoolConverter(src any) (any, error) {
+	const op errors.Op = "converters.common.ModelToTypeADIFBoolConverter"
+	switch v := src.(type) {
+	case bool:
+		return adifBool(v), nil
+	case null.Bool:
+		if !v.Valid {
+			return "", nil
+		}
+		return adifBool(v.Bool), nil
+	}
+	return "", errors.New(op).Errorf("Given parameter not a bool or null.Bool, got %T", src)
+}
+
+// parseADIFBool reads "Y"/"N"/"" from src, reporting empty separately.
+func parseADIFBool(op errors.Op, src any) (b bool, empty bool, err error) {
+	s, ok := src.(string)
+	if !ok {
+		return false, false, errors.New(op).Errorf("Given parameter not a string, got %T", src)
+	}
+	switch strings.ToUpper(strings.TrimSpace(s)) {
+	case "Y":
+		return true, false, nil
+	case "N":
+		return false, false, nil
+	case "":
+		return false, true, nil
+	}
+	return false, false, errors.New(op).Msg(converters.ErrMsgBadADIFBool)
+}
+
+func adifBool(b bool) string {
+	if b {
+		return "Y"
+	}
+	return "N"
+}
@@ -0,0 +1,79 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypeToModelADIFBoolConverter(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    interface{}
+		want     bool
+		wantNull null.Bool
+		wantErr  bool
+	}{
+		{name: "Y", input: "Y", want: true, wantNull: null.BoolFrom(true)},
+		{name: "y", input: "y", want: true, wantNull: null.BoolFrom(true)},
+		{name: "padded", input: " Y ", want: true, wantNull: null.BoolFrom(true)},
+		{name: "N", input: "N", want: false, wantNull: null.BoolFrom(false)},
+		{name: "n", input: "n", want: false, wantNull: null.BoolFrom(false)},
+		{name: "empty", input: "", want: false, wantNull: null.Bool{}},
+		{name: "X", input: "X", wantErr: true},
+		{name: "Yes", input: "Yes", wantErr: true},
+		{name: "not a string", input: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TypeToModelADIFBoolConverter(tt.input)
+			gotNull, nullErr := NewTypeToModelADIFBoolConverter(true)(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Error(t, nullErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, nullErr)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantNull, gotNull)
+		})
+	}
+}
+
+func TestNewTypeToModelADIFBoolConverter_EmptyIsFalse(t *testing.T) {
+	got, err := NewTypeToModelADIFBoolConverter(false)("")
+	require.NoError(t, err)
+	assert.Equal(t, false, got)
+}
+
+func TestModelToTypeADIFBoolConverter(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   interface{}
+		want    string
+		wantErr bool
+	}{
+		{name: "true", input: true, want: "Y"},
+		{name: "false", input: false, want: "N"},
+		{name: "null.Bool true", input: null.BoolFrom(true), want: "Y"},
+		{name: "null.Bool false", input: null.BoolFrom(false), want: "N"},
+		{name: "null.Bool invalid", input: null.Bool{}, want: ""},
+		{name: "string", input: "Y", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ModelToTypeADIFBoolConverter(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
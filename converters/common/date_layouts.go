@@ -0,0 +1,52 @@
+package common
+
+import (
+	"github.com/Station-Manager/adapters/converters"
+	"github.com/Station-Manager/errors"
+	"strings"
+	"time"
+)
+
+// DateConverterWithLayouts returns a converter that parses a date string with each of the given time.Parse
+// layouts in order and returns the first successful result as a time.Time (for models storing dates as
+// timestamps, like the postgres converters). Slash-separated imports are handled by listing the layout
+// explicitly, e.g. "02/01/2006" for DD/MM/YYYY or "01/02/2006" for MM/DD/YYYY; since such inputs are
+// ambiguous, the first matching layout wins. An input no layout accepts is an error naming the layouts.
+// The strict TypeToModelDateConverter functions in the sqlite and postgres packages are unchanged.
+func DateConverterWithLayouts(layouts ...string) converters.ConverterFunc {
+	return func(src any) (any, error) {
+		const op errors.Op = "converters.common.DateConverterWithLayouts"
+		t, err := parseDateLayouts(op, src, layouts)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return t, nil
+	}
+}
+
+// DateStringConverterWithLayouts is like DateConverterWithLayouts but returns the date as a YYYYMMDD string,
+// for models storing dates as strings like the sqlite converters.
+func DateStringConverterWithLayouts(layouts ...string) converters.ConverterFunc {
+	return func(src any) (any, error) {
+		const op errors.Op = "converters.common.DateStringConverterWithLayouts"
+		t, err := parseDateLayouts(op, src, layouts)
+		if err != nil {
+			return "", err
+		}
+		return t.Format("20060102"), nil
+	}
+}
+
+// parseDateLayouts parses the string src with the first layout that accepts it.
+func parseDateLayouts(op errors.Op, src any, layouts []string) (time.Time, error) {
+	s, err := converters.CheckString(op, src)
+	if err != nil {
+		return time.Time{}, errors.New(op).Err(err)
+	}
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.New(op).Errorf("Bad date %q, expected one of the layouts %s", s, strings.Join(layouts, ", "))
+}
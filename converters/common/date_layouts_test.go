@@ -0,0 +1,55 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateConverterWithLayouts(t *testing.T) {
+	european := []string{"20060102", "2006-01-02", "02/01/2006"}
+	american := []string{"20060102", "2006-01-02", "01/02/2006"}
+	tests := []struct {
+		name    string
+		layouts []string
+		input   interface{}
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "YYYYMMDD", layouts: european, input: "20240315", want: time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{name: "YYYY-MM-DD", layouts: european, input: "2024-03-15", want: time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{name: "DD/MM/YYYY", layouts: european, input: "04/03/2024", want: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{name: "MM/DD/YYYY", layouts: american, input: "04/03/2024", want: time.Date(2024, 4, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "first layout wins", layouts: []string{"01/02/2006", "02/01/2006"}, input: "04/03/2024", want: time.Date(2024, 4, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "falls through to later layout", layouts: []string{"01/02/2006", "02/01/2006"}, input: "15/03/2024", want: time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{name: "unparseable", layouts: european, input: "March 15th", wantErr: true},
+		{name: "DD/MM rejects month 13", layouts: european, input: "01/13/2024", wantErr: true},
+		{name: "no layouts", layouts: nil, input: "20240315", wantErr: true},
+		{name: "empty", layouts: european, input: "", wantErr: true},
+		{name: "not a string", layouts: european, input: 20240315, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DateConverterWithLayouts(tt.layouts...)(tt.input)
+			gotStr, strErr := DateStringConverterWithLayouts(tt.layouts...)(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Error(t, strErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, strErr)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want.Format("20060102"), gotStr)
+		})
+	}
+}
+
+func TestDateConverterWithLayouts_ErrorNamesLayouts(t *testing.T) {
+	_, err := DateConverterWithLayouts("02/01/2006", "2006-01-02")("15.03.2024")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "02/01/2006, 2006-01-02")
+}
@@ -0,0 +1,49 @@
+package common
+
+import (
+	"github.com/Station-Manager/adapters/converters"
+	"github.com/Station-Manager/errors"
+	"time"
+)
+
+// TypeToModelDurationConverter converts a time.Duration to an int64 number of whole seconds (rounded to nearest).
+func TypeToModelDurationConverter(src any) (any, error) {
+	return TypeToModelDurationConverterWith(time.Second)(src)
+}
+
+// ModelToTypeDurationConverter converts an int64 number of seconds to a time.Duration.
+// JSON float64 values holding an integer are accepted.
+func ModelToTypeDurationConverter(src any) (any, error) {
+	return ModelToTypeDurationConverterWith(time.Second)(src)
+}
+
+// TypeToModelDurationConverterWith returns a converter from a time.Duration to an int64 count of unit
+// (e.g. time.Second or time.Millisecond), rounded to the nearest unit.
+func TypeToModelDurationConverterWith(unit time.Duration) func(src any) (any, error) {
+	return func(src any) (any, error) {
+		const op errors.Op = "converters.common.TypeToModelDurationConverter"
+		if unit <= 0 {
+			return int64(0), errors.New(op).Errorf("Duration unit must be positive, got %v", unit)
+		}
+		srcVal, ok := src.(time.Duration)
+		if !ok {
+			return int64(0), errors.New(op).Errorf("Given parameter not a time.Duration, got %T", src)
+		}
+		return int64(srcVal.Round(unit) / unit), nil
+	}
+}
+
+// ModelToTypeDurationConverterWith returns a converter from an int64 count of unit to a time.Duration.
+func ModelToTypeDurationConverterWith(unit time.Duration) func(src any) (any, error) {
+	return func(src any) (any, error) {
+		const op errors.Op = "converters.common.ModelToTypeDurationConverter"
+		if unit <= 0 {
+			return time.Duration(0), errors.New(op).Errorf("Duration unit must be positive, got %v", unit)
+		}
+		srcVal, err := converters.CheckInt64(op, src)
+		if err != nil {
+			return time.Duration(0), errors.New(op).Err(err)
+		}
+		return time.Duration(srcVal) * unit, nil
+	}
+}
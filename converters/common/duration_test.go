@@ -0,0 +1,94 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypeToModelDurationConverter(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   interface{}
+		want    int64
+		wantErr bool
+	}{
+		{name: "zero", input: time.Duration(0), want: 0},
+		{name: "sub-second rounds down", input: 400 * time.Millisecond, want: 0},
+		{name: "sub-second rounds up", input: 600 * time.Millisecond, want: 1},
+		{name: "multi-hour", input: 3*time.Hour + 25*time.Minute + 7*time.Second, want: 12307},
+		{name: "int64 not accepted", input: int64(5), wantErr: true},
+		{name: "nil", input: nil, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TypeToModelDurationConverter(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestModelToTypeDurationConverter(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   interface{}
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "zero", input: int64(0), want: 0},
+		{name: "seconds", input: int64(90), want: 90 * time.Second},
+		{name: "JSON float64", input: float64(12307), want: 3*time.Hour + 25*time.Minute + 7*time.Second},
+		{name: "fractional float64", input: 1.5, wantErr: true},
+		{name: "string", input: "90", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ModelToTypeDurationConverter(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDurationRoundTrip(t *testing.T) {
+	testCases := []struct {
+		name  string
+		unit  time.Duration
+		value time.Duration
+	}{
+		{"zero seconds", time.Second, 0},
+		{"multi-hour seconds", time.Second, 5*time.Hour + 3*time.Second},
+		{"sub-second millis", time.Millisecond, 250 * time.Millisecond},
+		{"multi-hour millis", time.Millisecond, 2*time.Hour + 1500*time.Millisecond},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			model, err := TypeToModelDurationConverterWith(tc.unit)(tc.value)
+			require.NoError(t, err)
+			back, err := ModelToTypeDurationConverterWith(tc.unit)(model)
+			require.NoError(t, err)
+			assert.Equal(t, tc.value, back)
+		})
+	}
+}
+
+func TestDurationConverter_InvalidUnit(t *testing.T) {
+	_, err := TypeToModelDurationConverterWith(0)(time.Second)
+	assert.Error(t, err)
+	_, err = ModelToTypeDurationConverterWith(-time.Second)(int64(1))
+	assert.Error(t, err)
+}
@@ -1,10 +1,13 @@
 package common
 
 import (
+	"fmt"
 	"github.com/Station-Manager/adapters/converters"
 	"github.com/Station-Manager/errors"
 	"math"
+	"math/big"
 	"strconv"
+	"strings"
 )
 
 // TypeToModelFreqConverter converts a frequency value from a string to an int64.
@@ -36,3 +39,129 @@ func ModelToTypeFreqConverter(src any) (any, error) {
 	retVal := strconv.FormatFloat(val, 'f', 3, 64)
 	return retVal, nil
 }
+
+// RoundMode controls how frequencies are quantized to the configured number of decimals.
+type RoundMode int
+
+const (
+	RoundNearest RoundMode = iota // round half away from zero
+	RoundDown                     // truncate toward zero
+	RoundUp                       // round away from zero when any remainder exists
+)
+
+// FreqConverterConfig configures the frequency converter factories.
+// Decimals is the number of MHz decimal places kept (0-6, where 6 is Hz precision).
+type FreqConverterConfig struct {
+	Decimals int
+	Rounding RoundMode
+}
+
+// TypeToModelFreqConverterWith returns a converter from a MHz string to an int64 Hz value, quantized to
+// cfg.Decimals MHz places using cfg.Rounding. Parsing is exact, so "14.3205" is never misrounded by float error.
+func TypeToModelFreqConverterWith(cfg FreqConverterConfig) func(src any) (any, error) {
+	return func(src any) (any, error) {
+		const op errors.Op = "converters.common.TypeToModelFreqConverterWith"
+		if err := cfg.validate(); err != nil {
+			return 0, errors.New(op).Err(err)
+		}
+		srcVal, err := converters.CheckString(op, src)
+		if err != nil {
+			return 0, errors.New(op).Err(err)
+		}
+		r, ok := new(big.Rat).SetString(srcVal)
+		if !ok {
+			return 0, errors.New(op).Errorf("Invalid frequency %q", srcVal)
+		}
+		r.Mul(r, new(big.Rat).SetInt64(pow10(cfg.Decimals)))
+		q, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+		if !q.IsInt64() {
+			return 0, errors.New(op).Errorf("Frequency out of range: %q", srcVal)
+		}
+		// rem carries the sign of the numerator; compare |2*rem| against the denominator for ties
+		twice := new(big.Int).Abs(rem)
+		twice.Lsh(twice, 1)
+		steps := roundQuotient(q.Int64(), rem.Sign(), twice.Cmp(r.Denom()), cfg.Rounding)
+		return steps * pow10(6-cfg.Decimals), nil
+	}
+}
+
+// ModelToTypeFreqConverterWith returns a converter from an int64 Hz value to a MHz string with cfg.Decimals
+// places, rounding the dropped digits using cfg.Rounding.
+func ModelToTypeFreqConverterWith(cfg FreqConverterConfig) func(src any) (any, error) {
+	return func(src any) (any, error) {
+		const op errors.Op = "converters.common.ModelToTypeFreqConverterWith"
+		if err := cfg.validate(); err != nil {
+			return "", errors.New(op).Err(err)
+		}
+		srcVal, err := converters.CheckInt64(op, src)
+		if err != nil {
+			return "", errors.New(op).Err(err)
+		}
+		unit := pow10(6 - cfg.Decimals)
+		q, rem := srcVal/unit, srcVal%unit
+		sign := 0
+		if rem > 0 {
+			sign = 1
+		} else if rem < 0 {
+			sign, rem = -1, -rem
+		}
+		cmp := 0
+		if 2*rem > unit {
+			cmp = 1
+		} else if 2*rem < unit {
+			cmp = -1
+		}
+		steps := roundQuotient(q, sign, cmp, cfg.Rounding)
+		neg := steps < 0
+		if neg {
+			steps = -steps
+		}
+		scale := pow10(cfg.Decimals)
+		retVal := strconv.FormatInt(steps/scale, 10)
+		if cfg.Decimals > 0 {
+			frac := strconv.FormatInt(steps%scale, 10)
+			retVal += "." + strings.Repeat("0", cfg.Decimals-len(frac)) + frac
+		}
+		if neg {
+			retVal = "-" + retVal
+		}
+		return retVal, nil
+	}
+}
+
+func (c FreqConverterConfig) validate() error {
+	if c.Decimals < 0 || c.Decimals > 6 {
+		return fmt.Errorf("decimals must be between 0 and 6, got %d", c.Decimals)
+	}
+	if c.Rounding < RoundNearest || c.Rounding > RoundUp {
+		return fmt.Errorf("unknown rounding mode %d", c.Rounding)
+	}
+	return nil
+}
+
+// roundQuotient applies mode to a truncated quotient q. remSign is the sign of the discarded remainder and
+// halfCmp compares twice the remainder's magnitude against the divisor (-1 below half, 0 tie, 1 above).
+func roundQuotient(q int64, remSign, halfCmp int, mode RoundMode) int64 {
+	if remSign == 0 {
+		return q
+	}
+	switch mode {
+	case RoundDown:
+		return q
+	case RoundUp:
+		return q + int64(remSign)
+	default:
+		if halfCmp >= 0 {
+			return q + int64(remSign)
+		}
+		return q
+	}
+}
+
+func pow10(n int) int64 {
+	p := int64(1)
+	for i := 0; i < n; i++ {
+		p *= 10
+	}
+	return p
+}
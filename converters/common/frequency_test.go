@@ -188,3 +188,71 @@ func TestFrequencyRoundTrip(t *testing.T) {
 		})
 	}
 }
+
+func TestTypeToModelFreqConverterWith(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     FreqConverterConfig
+		input   interface{}
+		want    int64
+		wantErr bool
+	}{
+		{name: "nearest kHz at tie", cfg: FreqConverterConfig{Decimals: 3, Rounding: RoundNearest}, input: "14.3205", want: 14321000},
+		{name: "down kHz at tie", cfg: FreqConverterConfig{Decimals: 3, Rounding: RoundDown}, input: "14.3205", want: 14320000},
+		{name: "up kHz at tie", cfg: FreqConverterConfig{Decimals: 3, Rounding: RoundUp}, input: "14.3205", want: 14321000},
+		{name: "nearest below half", cfg: FreqConverterConfig{Decimals: 3, Rounding: RoundNearest}, input: "14.3204", want: 14320000},
+		{name: "up with tiny remainder", cfg: FreqConverterConfig{Decimals: 3, Rounding: RoundUp}, input: "14.3200001", want: 14321000},
+		{name: "Hz precision keeps digits", cfg: FreqConverterConfig{Decimals: 6, Rounding: RoundDown}, input: "14.3205", want: 14320500},
+		{name: "exact value unchanged", cfg: FreqConverterConfig{Decimals: 3, Rounding: RoundUp}, input: "7.074", want: 7074000},
+		{name: "invalid decimals", cfg: FreqConverterConfig{Decimals: 7}, input: "14.320", wantErr: true},
+		{name: "invalid mode", cfg: FreqConverterConfig{Decimals: 3, Rounding: RoundMode(9)}, input: "14.320", wantErr: true},
+		{name: "empty string", cfg: FreqConverterConfig{Decimals: 3}, input: "", wantErr: true},
+		{name: "not a number", cfg: FreqConverterConfig{Decimals: 3}, input: "abc", wantErr: true},
+		{name: "non-string", cfg: FreqConverterConfig{Decimals: 3}, input: 14.32, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TypeToModelFreqConverterWith(tt.cfg)(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestModelToTypeFreqConverterWith(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     FreqConverterConfig
+		input   interface{}
+		want    string
+		wantErr bool
+	}{
+		{name: "nearest at tie", cfg: FreqConverterConfig{Decimals: 3, Rounding: RoundNearest}, input: int64(14320500), want: "14.321"},
+		{name: "down at tie", cfg: FreqConverterConfig{Decimals: 3, Rounding: RoundDown}, input: int64(14320500), want: "14.320"},
+		{name: "up at tie", cfg: FreqConverterConfig{Decimals: 3, Rounding: RoundUp}, input: int64(14320500), want: "14.321"},
+		{name: "up with 1Hz remainder", cfg: FreqConverterConfig{Decimals: 3, Rounding: RoundUp}, input: int64(14320001), want: "14.321"},
+		{name: "four decimals keep digit", cfg: FreqConverterConfig{Decimals: 4, Rounding: RoundNearest}, input: int64(14320500), want: "14.3205"},
+		{name: "zero decimals", cfg: FreqConverterConfig{Decimals: 0, Rounding: RoundNearest}, input: int64(144500000), want: "145"},
+		{name: "leading zero fraction", cfg: FreqConverterConfig{Decimals: 3, Rounding: RoundDown}, input: int64(1840000), want: "1.840"},
+		{name: "sub MHz", cfg: FreqConverterConfig{Decimals: 6, Rounding: RoundDown}, input: int64(137), want: "0.000137"},
+		{name: "invalid decimals", cfg: FreqConverterConfig{Decimals: -1}, input: int64(1), wantErr: true},
+		{name: "non-integer", cfg: FreqConverterConfig{Decimals: 3}, input: "14.320", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ModelToTypeFreqConverterWith(tt.cfg)(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package common
+
+import (
+	"fmt"
+	"github.com/Station-Manager/adapters/converters"
+	"github.com/Station-Manager/errors"
+	"github.com/aarondl/null/v8"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// coordinate describes one ADIF location axis: the hemisphere letters for positive and negative values and
+// the largest number of degrees.
+type coordinate struct {
+	pos, neg byte
+	maxDeg   int
+	badMsg   string
+}
+
+var (
+	latitude  = coordinate{pos: 'N', neg: 'S', maxDeg: 90, badMsg: converters.ErrMsgBadLatitude}
+	longitude = coordinate{pos: 'E', neg: 'W', maxDeg: 180, badMsg: converters.ErrMsgBadLongitude}
+)
+
+// TypeToModelLatConverter converts an ADIF latitude ("N052 12.345": hemisphere, three-digit degrees, a space,
+// then minutes with three decimals) to signed decimal degrees as a float64, south negative, rounded to six
+// decimal places. Surrounding spaces are ignored and the letter may be lower case. Any other letter, more than
+// 90 degrees or minutes of 60 or more is an error, and so is an empty string: wrap the converter with
+// converters.NullSafe when locations are optional.
+func TypeToModelLatConverter(src any) (any, error) {
+	const op errors.Op = "converters.common.TypeToModelLatConverter"
+	return parseCoordinate(op, latitude, src)
+}
+
+// TypeToModelLongConverter is TypeToModelLatConverter for longitudes ("W001 30.000"): east is positive, west
+// negative, at most 180 degrees.
+func TypeToModelLongConverter(src any) (any, error) {
+	const op errors.Op = "converters.common.TypeToModelLongConverter"
+	return parseCoordinate(op, longitude, src)
+}
+
+// ModelToTypeLatConverter converts decimal degrees (float64, float32 or null.Float64) to an ADIF latitude,
+// e.g. 52.20575 -> "N052 12.345". An invalid (null) null.Float64 becomes "". Values beyond ±90 are an error.
+func ModelToTypeLatConverter(src any) (any, error) {
+	const op errors.Op = "converters.common.ModelToTypeLatConverter"
+	return formatCoordinate(op, latitude, src)
+}
+
+// ModelToTypeLongConverter is ModelToTypeLatConverter for longitudes, e.g. -1.5 -> "W001 30.000". Values
+// beyond ±180 are an error.
+func ModelToTypeLongConverter(src any) (any, error) {
+	const op errors.Op = "converters.common.ModelToTypeLongConverter"
+	return formatCoordinate(op, longitude, src)
+}
+
+// parseCoordinate reads an ADIF location string for axis c.
+func parseCoordinate(op errors.Op, c coordinate, src any) (float64, error) {
+	s, ok := src.(string)
+	if !ok {
+		return 0, errors.New(op).Errorf("Given parameter not a string, got %T", src)
+	}
+	s = strings.ToUpper(strings.TrimSpace(s))
+	// XDDD MM.MMM
+	if len(s) != 11 || s[4] != ' ' || s[7] != '.' || !isDigits(s[1:4]) || !isDigits(s[5:7]) || !isDigits(s[8:]) {
+		return 0, errors.New(op).Msg(c.badMsg)
+	}
+	deg, _ := strconv.Atoi(s[1:4])
+	min, _ := strconv.ParseFloat(s[5:], 64)
+	if min >= 60 || deg > c.maxDeg || (deg == c.maxDeg && min > 0) {
+		return 0, errors.New(op).Msg(c.badMsg)
+	}
+	v := roundTo(float64(deg)+min/60, 6)
+	switch s[0] {
+	case c.pos:
+		return v, nil
+	case c.neg:
+		return -v, nil
+	}
+	return 0, errors.New(op).Msg(c.badMsg)
+}
+
+// formatCoordinate renders decimal degrees as an ADIF location string for axis c.
+func formatCoordinate(op errors.Op, c coordinate, src any) (string, error) {
+	var v float64
+	switch x := src.(type) {
+	case float64:
+		v = x
+	case float32:
+		v = float64(x)
+	case null.Float64:
+		if !x.Valid {
+			return "", nil
+		}
+		v = x.Float64
+	default:
+		return "", errors.New(op).Errorf("Given parameter not a float64, float32 or null.Float64, got %T", src)
+	}
+	if math.IsNaN(v) || math.Abs(v) > float64(c.maxDeg) {
+		return "", errors.New(op).Errorf("Given coordinate out of range ±%d, got %v", c.maxDeg, v)
+	}
+	hemi := c.pos
+	if v < 0 {
+		hemi = c.neg
+	}
+	abs := math.Abs(v)
+	deg := math.Floor(abs)
+	min := roundTo((abs-deg)*60, 3)
+	if min >= 60 {
+		deg, min = deg+1, 0
+	}
+	return fmt.Sprintf("%c%03d %06.3f", hemi, int(deg), min), nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
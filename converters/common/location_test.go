@@ -0,0 +1,122 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypeToModelLatConverter(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   interface{}
+		want    float64
+		wantErr bool
+	}{
+		{name: "north", input: "N052 12.345", want: 52.20575},
+		{name: "south", input: "S033 52.000", want: -33.866667},
+		{name: "equator", input: "N000 00.000", want: 0},
+		{name: "north pole", input: "N090 00.000", want: 90},
+		{name: "south pole", input: "S090 00.000", want: -90},
+		{name: "lower case padded", input: " n052 12.345 ", want: 52.20575},
+		{name: "beyond pole", input: "N090 00.001", wantErr: true},
+		{name: "too many degrees", input: "N091 00.000", wantErr: true},
+		{name: "minutes out of range", input: "N052 60.000", wantErr: true},
+		{name: "longitude letter", input: "E052 12.345", wantErr: true},
+		{name: "two-digit degrees", input: "N52 12.345", wantErr: true},
+		{name: "missing space", input: "N05212.3450", wantErr: true},
+		{name: "empty", input: "", wantErr: true},
+		{name: "not a string", input: 52.2, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TypeToModelLatConverter(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTypeToModelLongConverter(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   interface{}
+		want    float64
+		wantErr bool
+	}{
+		{name: "west", input: "W001 30.000", want: -1.5},
+		{name: "east", input: "E151 12.600", want: 151.21},
+		{name: "prime meridian", input: "E000 00.000", want: 0},
+		{name: "antimeridian", input: "W180 00.000", want: -180},
+		{name: "beyond antimeridian", input: "E180 30.000", wantErr: true},
+		{name: "latitude letter", input: "N001 30.000", wantErr: true},
+		{name: "letters in minutes", input: "W001 3O.000", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TypeToModelLongConverter(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestModelToTypeLocationConverters(t *testing.T) {
+	got, err := ModelToTypeLatConverter(52.20575)
+	require.NoError(t, err)
+	assert.Equal(t, "N052 12.345", got)
+
+	got, err = ModelToTypeLatConverter(float32(-90))
+	require.NoError(t, err)
+	assert.Equal(t, "S090 00.000", got)
+
+	got, err = ModelToTypeLongConverter(-1.5)
+	require.NoError(t, err)
+	assert.Equal(t, "W001 30.000", got)
+
+	got, err = ModelToTypeLongConverter(0.0)
+	require.NoError(t, err)
+	assert.Equal(t, "E000 00.000", got)
+
+	got, err = ModelToTypeLongConverter(179.9999999)
+	require.NoError(t, err)
+	assert.Equal(t, "E180 00.000", got, "minutes rounding up carry into the degrees")
+
+	got, err = ModelToTypeLatConverter(null.Float64{})
+	require.NoError(t, err)
+	assert.Equal(t, "", got)
+
+	_, err = ModelToTypeLatConverter(90.5)
+	assert.Error(t, err)
+	_, err = ModelToTypeLongConverter("W001 30.000")
+	assert.Error(t, err)
+}
+
+func TestLocationConverters_RoundTrip(t *testing.T) {
+	for _, s := range []string{"N052 12.345", "S000 00.001", "N090 00.000", "S045 59.999"} {
+		v, err := TypeToModelLatConverter(s)
+		require.NoError(t, err)
+		back, err := ModelToTypeLatConverter(v)
+		require.NoError(t, err)
+		assert.Equal(t, s, back)
+	}
+	for _, s := range []string{"W001 30.000", "E000 00.000", "W180 00.000", "E012 34.567"} {
+		v, err := TypeToModelLongConverter(s)
+		require.NoError(t, err)
+		back, err := ModelToTypeLongConverter(v)
+		require.NoError(t, err)
+		assert.Equal(t, s, back)
+	}
+}
@@ -7,15 +7,17 @@ import (
 )
 
 // TypeToModelTimeConverter converts a time.Time to a model null.Time.
+// It always returns a null.Time so the result is assignable to a null.Time field; the zero time becomes an
+// invalid (null) value rather than an error.
 func TypeToModelTimeConverter(src any) (any, error) {
 	const op errors.Op = "converters.common.TypeToModelTimeConverter"
 	srcVal, ok := src.(time.Time)
 	if !ok {
-		return null.Time{}, errors.New(op).Errorf("Given parameter not a string, got %T", src)
+		return null.Time{}, errors.New(op).Errorf("Given parameter not a time.Time, got %T", src)
 	}
-	// Treat empty time as a valid time, do not error
+	// Treat empty time as null, do not error
 	if srcVal.IsZero() {
-		return srcVal.UTC(), nil
+		return null.Time{}, nil
 	}
 	return null.TimeFrom(srcVal), nil
 }
@@ -35,5 +37,5 @@ func ModelToTypeTimeConverter(src any) (any, error) {
 		return s, nil
 	}
 
-	return time.Time{}, errors.New(op).Errorf("Given parameter not a string or null.String, got %T", src)
+	return time.Time{}, errors.New(op).Errorf("Given parameter not a time.Time or null.Time, got %T", src)
 }
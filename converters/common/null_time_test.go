@@ -0,0 +1,71 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypeToModelTimeConverter(t *testing.T) {
+	when := time.Date(2025, 11, 3, 14, 20, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		input     interface{}
+		wantValid bool
+		wantValue time.Time
+		wantErr   bool
+	}{
+		{
+			name:      "valid time",
+			input:     when,
+			wantValid: true,
+			wantValue: when,
+		},
+		{
+			name:      "zero time treated as null",
+			input:     time.Time{},
+			wantValid: false,
+		},
+		{
+			name:    "non-time input (string)",
+			input:   "2025-11-03",
+			wantErr: true,
+		},
+		{
+			name:    "non-time input (nil)",
+			input:   nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TypeToModelTimeConverter(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			nullTime, ok := got.(null.Time)
+			require.True(t, ok, "result should be null.Time")
+			assert.Equal(t, tt.wantValid, nullTime.Valid)
+			if tt.wantValid {
+				assert.True(t, tt.wantValue.Equal(nullTime.Time))
+			}
+		})
+	}
+}
+
+func TestTimeRoundTrip(t *testing.T) {
+	for _, v := range []time.Time{time.Date(2025, 11, 3, 14, 20, 0, 0, time.UTC), {}} {
+		modelVal, err := TypeToModelTimeConverter(v)
+		require.NoError(t, err)
+		typeVal, err := ModelToTypeTimeConverter(modelVal)
+		require.NoError(t, err)
+		assert.True(t, v.Equal(typeVal.(time.Time)))
+	}
+}
@@ -0,0 +1,71 @@
+package common
+
+import (
+	"github.com/Station-Manager/adapters/converters"
+	"github.com/Station-Manager/errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// WattsToDbm converts a TX power in Watts to dBm, rounded to two decimal places (5 W -> 36.99, 100 W -> 50).
+// The source may be a string, as in types.QsoDetails.TxPwr, or a number. The result is a float64. 0 W, negative
+// and empty values have no dBm value and return an error.
+func WattsToDbm(src any) (any, error) {
+	const op errors.Op = "converters.common.WattsToDbm"
+	w, err := powerValue(op, src)
+	if err != nil {
+		return float64(0), err
+	}
+	if w <= 0 || math.IsInf(w, 0) || math.IsNaN(w) {
+		return float64(0), errors.New(op).Msg(converters.ErrMsgBadPower)
+	}
+	return roundTo(10*math.Log10(w)+30, 2), nil
+}
+
+// DbmToWatts converts a power in dBm to a Watts string rounded to two decimal places, without trailing zeros
+// (50 -> "100", 36.99 -> "5"). It is the inverse of WattsToDbm for a string TxPwr field; round trips are exact
+// to within about 0.12% of the original power (the dBm rounding).
+func DbmToWatts(src any) (any, error) {
+	const op errors.Op = "converters.common.DbmToWatts"
+	dbm, err := powerValue(op, src)
+	if err != nil {
+		return "", err
+	}
+	if math.IsInf(dbm, 0) || math.IsNaN(dbm) {
+		return "", errors.New(op).Errorf("Given dBm value not finite, got %v", dbm)
+	}
+	w := roundTo(math.Pow(10, (dbm-30)/10), 2)
+	return strconv.FormatFloat(w, 'f', -1, 64), nil
+}
+
+// powerValue reads a power value from a string or numeric source.
+func powerValue(op errors.Op, src any) (float64, error) {
+	switch v := src.(type) {
+	case string:
+		s := strings.TrimSpace(v)
+		if s == "" {
+			return 0, errors.New(op).Msg(converters.ErrMsgBadPower)
+		}
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0, errors.New(op).Err(err)
+		}
+		return f, nil
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	default:
+		n, err := converters.CheckInt64(op, src)
+		if err != nil {
+			return 0, errors.New(op).Err(err)
+		}
+		return float64(n), nil
+	}
+}
+
+func roundTo(v float64, places int) float64 {
+	p := math.Pow(10, float64(places))
+	return math.Round(v*p) / p
+}
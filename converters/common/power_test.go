@@ -0,0 +1,83 @@
+package common
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWattsToDbm(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   interface{}
+		want    float64
+		wantErr bool
+	}{
+		{name: "1 mW", input: "0.001", want: 0},
+		{name: "5 W QRP", input: "5", want: 36.99},
+		{name: "100 W", input: "100", want: 50},
+		{name: "1500 W", input: "1500", want: 61.76},
+		{name: "float64", input: 100.0, want: 50},
+		{name: "int", input: 10, want: 40},
+		{name: "padded string", input: " 100 ", want: 50},
+		{name: "zero", input: "0", wantErr: true},
+		{name: "negative", input: -5.0, wantErr: true},
+		{name: "empty", input: "", wantErr: true},
+		{name: "not a number", input: "QRP", wantErr: true},
+		{name: "nil", input: nil, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := WattsToDbm(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDbmToWatts(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   interface{}
+		want    string
+		wantErr bool
+	}{
+		{name: "50 dBm", input: 50.0, want: "100"},
+		{name: "30 dBm", input: int64(30), want: "1"},
+		{name: "negative dBm", input: -10.0, want: "0"},
+		{name: "36.99 dBm", input: 36.99, want: "5"},
+		{name: "string", input: "40", want: "10"},
+		{name: "bool", input: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DbmToWatts(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPowerRoundTrip(t *testing.T) {
+	for _, w := range []float64{0.5, 5, 10, 50, 100, 400, 1000, 1500} {
+		dbm, err := WattsToDbm(strconv.FormatFloat(w, 'f', -1, 64))
+		require.NoError(t, err)
+		back, err := DbmToWatts(dbm)
+		require.NoError(t, err)
+		got, err := strconv.ParseFloat(back.(string), 64)
+		require.NoError(t, err)
+		assert.InEpsilon(t, w, got, 0.0012, "%v W -> %v dBm -> %v W", w, dbm, back)
+	}
+}
@@ -0,0 +1,78 @@
+package common
+
+import (
+	"strings"
+
+	"github.com/Station-Manager/adapters/converters"
+	"github.com/Station-Manager/errors"
+)
+
+// rstDigits returns how many digits a signal report has in mode: 2 (RS) for phone modes, 3 (RST) for CW,
+// or 0 for modes whose reports are not RS(T), e.g. digital modes reporting dB.
+func rstDigits(mode string) int {
+	switch strings.ToUpper(strings.TrimSpace(mode)) {
+	case "SSB", "USB", "LSB", "AM", "FM", "DV":
+		return 2
+	case "CW":
+		return 3
+	default:
+		return 0
+	}
+}
+
+// NormalizeRST trims a signal report and expands CW cut numbers (5NN -> 599). N is only expanded in the
+// strength and tone positions of a two- or three-character report; anything else, including empty reports,
+// is returned trimmed but otherwise unchanged. It does not check ranges; pair it with ValidateRST for that.
+func NormalizeRST(src any) (any, error) {
+	const op errors.Op = "converters.common.NormalizeRST"
+	srcVal, ok := src.(string)
+	if !ok {
+		return "", errors.New(op).Errorf("Given parameter not a string, got %T", src)
+	}
+	srcVal = strings.TrimSpace(srcVal)
+	if len(srcVal) < 2 || len(srcVal) > 3 || srcVal[0] < '0' || srcVal[0] > '9' {
+		return srcVal, nil
+	}
+	out := []byte(srcVal)
+	for i := 1; i < len(out); i++ {
+		switch {
+		case out[i] >= '0' && out[i] <= '9':
+		case out[i] == 'N' || out[i] == 'n':
+			out[i] = '9'
+		default:
+			return srcVal, nil
+		}
+	}
+	return string(out), nil
+}
+
+// ValidateRST returns a validator for RstSent/RstRcvd reports in mode. Phone modes (SSB, USB, LSB, AM, FM, DV)
+// require an RS report and CW an RST report, with Readability 1-5, Strength 1-9 and Tone 1-9. Empty reports
+// pass, as do reports in modes that do not use RS(T) such as FT8.
+func ValidateRST(mode string) converters.ValidatorFunc {
+	const op errors.Op = "converters.common.ValidateRST"
+	digits := rstDigits(mode)
+	return func(value any) error {
+		report, ok := value.(string)
+		if !ok {
+			return errors.New(op).Errorf("Given parameter not a string, got %T", value)
+		}
+		if report == "" || digits == 0 {
+			return nil
+		}
+		if len(report) != digits {
+			return errors.New(op).Errorf("%s: %q has %d digits, %s needs %d", converters.ErrMsgBadRSTFormat, report, len(report), strings.ToUpper(mode), digits)
+		}
+		for i, name := range []string{"readability", "strength", "tone"}[:digits] {
+			c := report[i]
+			limit := byte('9')
+			if i == 0 {
+				limit = '5'
+			}
+			if c < '1' || c > limit {
+				return errors.New(op).Errorf("%s: %q has %s %q, expected 1-%c", converters.ErrMsgBadRSTFormat, report, name, c, limit)
+			}
+		}
+		return nil
+	}
+}
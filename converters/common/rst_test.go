@@ -0,0 +1,82 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeRST(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   any
+		want    string
+		wantErr bool
+	}{
+		{name: "plain RST", input: "599", want: "599"},
+		{name: "trimmed", input: " 59 ", want: "59"},
+		{name: "cut numbers", input: "5NN", want: "599"},
+		{name: "lower case cut numbers", input: "5nn", want: "599"},
+		{name: "empty", input: "", want: ""},
+		{name: "cut number in readability left alone", input: "N99", want: "N99"},
+		{name: "malformed left alone", input: "NONE", want: "NONE"},
+		{name: "letters in report left alone", input: "5NX", want: "5NX"},
+		{name: "dB report left alone", input: "-10", want: "-10"},
+		{name: "not a string", input: 599, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeRST(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidateRST(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    string
+		input   any
+		wantErr bool
+	}{
+		{name: "SSB RS", mode: "SSB", input: "59"},
+		{name: "SSB weakest", mode: "SSB", input: "11"},
+		{name: "SSB lower case mode", mode: "usb", input: "57"},
+		{name: "SSB rejects RST", mode: "SSB", input: "599", wantErr: true},
+		{name: "CW RST", mode: "CW", input: "599"},
+		{name: "CW weakest", mode: "CW", input: "111"},
+		{name: "CW rejects RS", mode: "CW", input: "59", wantErr: true},
+		{name: "readability too high", mode: "SSB", input: "69", wantErr: true},
+		{name: "readability zero", mode: "CW", input: "099", wantErr: true},
+		{name: "strength zero", mode: "SSB", input: "50", wantErr: true},
+		{name: "tone zero", mode: "CW", input: "590", wantErr: true},
+		{name: "non-digit", mode: "CW", input: "5N9", wantErr: true},
+		{name: "empty passes", mode: "CW", input: ""},
+		{name: "digital mode not checked", mode: "FT8", input: "-10"},
+		{name: "not a string", mode: "CW", input: 599, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRST(tt.mode)(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateRST_DescriptiveError(t *testing.T) {
+	err := ValidateRST("CW")("569")
+	assert.NoError(t, err)
+	err = ValidateRST("CW")("509")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "strength")
+}
@@ -4,4 +4,9 @@ const (
 	ErrMsgFreqParamEmpty = "Frequency parameter cannot be empty."
 	ErrMsgBadTimeFormat  = "Bad time format, expected HH:MM or HHMM"
 	ErrMsgBadDateFormat  = "Bad date format, expected YYYYMMDD or YYYY-MM-DD"
+	ErrMsgBadRSTFormat   = "Bad signal report, expected RS (e.g. 59) for phone or RST (e.g. 599) for CW"
+	ErrMsgBadPower       = "Power must be greater than 0 W"
+	ErrMsgBadADIFBool    = "Bad ADIF boolean, expected Y or N"
+	ErrMsgBadLatitude    = "Bad latitude, expected N or S followed by DDD MM.MMM (e.g. N052 12.345), at most 90 degrees"
+	ErrMsgBadLongitude   = "Bad longitude, expected E or W followed by DDD MM.MMM (e.g. W001 30.000), at most 180 degrees"
 )
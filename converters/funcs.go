@@ -0,0 +1,8 @@
+package converters
+
+// ConverterFunc mirrors adapters.ConverterFunc so converter helpers can be composed without importing the
+// adapters package. Being an alias, values are directly assignable to adapters.ConverterFunc.
+type ConverterFunc = func(src any) (any, error)
+
+// ValidatorFunc mirrors adapters.ValidatorFunc in the same way.
+type ValidatorFunc = func(value any) error
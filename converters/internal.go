@@ -44,9 +44,9 @@ func CheckInt64(op errors.Op, src any) (int64, error) {
 	case int8:
 		return int64(v), nil
 	case uint:
-		return int64(v), nil
+		return checkUint64(op, uint64(v))
 	case uint64:
-		return int64(v), nil
+		return checkUint64(op, v)
 	case uint32:
 		return int64(v), nil
 	case uint16:
@@ -64,6 +64,14 @@ func CheckInt64(op errors.Op, src any) (int64, error) {
 	}
 }
 
+// checkUint64 converts v to int64, rejecting values above math.MaxInt64 instead of wrapping them negative.
+func checkUint64(op errors.Op, v uint64) (int64, error) {
+	if v > math.MaxInt64 {
+		return -1, errors.New(op).Errorf("Given unsigned integer overflows int64, got %d", v)
+	}
+	return int64(v), nil
+}
+
 func CheckTime(op errors.Op, src any) (time.Time, error) {
 	srcVal, ok := src.(time.Time)
 	if !ok {
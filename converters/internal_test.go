@@ -1,6 +1,7 @@
 package converters
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -240,6 +241,24 @@ func TestCheckInt64(t *testing.T) {
 			want:    9223372036854775807,
 			wantErr: false,
 		},
+		{
+			name:    "uint64 at MaxInt64",
+			input:   uint64(math.MaxInt64),
+			want:    math.MaxInt64,
+			wantErr: false,
+		},
+		{
+			name:    "uint64 just above MaxInt64",
+			input:   uint64(math.MaxInt64) + 1,
+			want:    -1,
+			wantErr: true,
+		},
+		{
+			name:    "uint64 max",
+			input:   uint64(math.MaxUint64),
+			want:    -1,
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
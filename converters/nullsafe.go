@@ -0,0 +1,55 @@
+package converters
+
+import "reflect"
+
+// NullSafe wraps a model-to-type converter so that null-ish inputs produce a clean zero result instead of an error.
+// The wrapped converter is not called and (nil, nil) is returned - which the adapter assigns as the destination's
+// zero value - when the input is:
+//   - nil
+//   - an invalid nullable wrapper (null.X, sql.NullX or any two-field struct with a `Valid bool` field)
+//   - the zero value of its type (e.g. "", 0 or time.Time{})
+//
+// A valid nullable wrapper is unwrapped and its inner value passed to fn. Any other input is passed through unchanged,
+// so genuine type errors are still reported.
+//
+// Built-ins that error on empty/zero input and benefit from wrapping:
+//   - common.ModelToTypeFreqConverter
+//   - sqlite.ModelToTypeDateConverter, sqlite.ModelToTypeTimeConverter
+//   - postgres.ModelToTypeDateConverter, postgres.ModelToTypeTimeConverter
+//
+// common.ModelToTypeStringConverter, common.ModelToTypeBoolConverter and common.ModelToTypeTimeConverter already
+// tolerate invalid input and do not need wrapping.
+func NullSafe(fn ConverterFunc) ConverterFunc {
+	return func(src any) (any, error) {
+		if src == nil {
+			return nil, nil
+		}
+		v := reflect.ValueOf(src)
+		if inner, valid, ok := unwrapNullable(v); ok {
+			if !valid {
+				return nil, nil
+			}
+			v = inner
+			src = inner.Interface()
+		}
+		if v.IsZero() {
+			return nil, nil
+		}
+		return fn(src)
+	}
+}
+
+// unwrapNullable recognizes two-field structs with a `Valid bool` field and returns the other field's value.
+func unwrapNullable(v reflect.Value) (reflect.Value, bool, bool) {
+	t := v.Type()
+	if t.Kind() != reflect.Struct || t.NumField() != 2 {
+		return reflect.Value{}, false, false
+	}
+	for i := 0; i < 2; i++ {
+		f := t.Field(i)
+		if f.Name == "Valid" && f.Type.Kind() == reflect.Bool && t.Field(1-i).IsExported() {
+			return v.Field(1 - i), v.Field(i).Bool(), true
+		}
+	}
+	return reflect.Value{}, false, false
+}
@@ -0,0 +1,57 @@
+package converters
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullSafe(t *testing.T) {
+	calls := 0
+	fn := NullSafe(func(src any) (any, error) {
+		calls++
+		if s, ok := src.(string); ok {
+			return s + "!", nil
+		}
+		return nil, errors.New("unexpected type")
+	})
+
+	tests := []struct {
+		name      string
+		input     interface{}
+		want      interface{}
+		wantErr   bool
+		wantCalls int
+	}{
+		{name: "nil", input: nil, want: nil},
+		{name: "empty string", input: "", want: nil},
+		{name: "zero int", input: int64(0), want: nil},
+		{name: "zero time", input: time.Time{}, want: nil},
+		{name: "invalid null.String", input: null.String{}, want: nil},
+		{name: "invalid sql.NullString", input: sql.NullString{}, want: nil},
+		{name: "valid null.String unwrapped", input: null.StringFrom("a"), want: "a!", wantCalls: 1},
+		{name: "valid sql.NullString unwrapped", input: sql.NullString{String: "b", Valid: true}, want: "b!", wantCalls: 1},
+		{name: "valid but empty wrapper", input: null.StringFrom(""), want: nil},
+		{name: "plain value", input: "c", want: "c!", wantCalls: 1},
+		{name: "type error propagates", input: 5, wantErr: true, wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls = 0
+			got, err := fn(tt.input)
+			assert.Equal(t, tt.wantCalls, calls)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package sqlite
+
+import (
+	"github.com/Station-Manager/adapters"
+	"github.com/Station-Manager/adapters/converters/common"
+	"github.com/Station-Manager/database/sqlite/models"
+	"github.com/Station-Manager/types"
+)
+
+// RegisterSQLiteConverters registers the converters for adapting types.Qso to and from the sqlite models.Qso on adapter
+// a in one batch: Freq, QsoDate, TimeOn, TimeOff and Country. They are scoped by destination type, so the same adapter
+// serves both directions and the registrations do not affect other structs with the same field names. Registering
+// further converters for these fields afterwards replaces the preset ones.
+func RegisterSQLiteConverters(a *adapters.Adapter) {
+	a.Batch(func(b *adapters.RegistryBatch) {
+		// types -> models
+		b.ConverterFor(models.Qso{}, "Freq", common.TypeToModelFreqConverter)
+		b.ConverterFor(models.Qso{}, "QsoDate", TypeToModelDateConverter)
+		b.ConverterFor(models.Qso{}, "TimeOn", TypeToModelTimeConverter)
+		b.ConverterFor(models.Qso{}, "TimeOff", TypeToModelTimeConverter)
+		b.ConverterFor(models.Qso{}, "Country", common.TypeToModelStringConverter)
+		// models -> types
+		b.ConverterFor(types.Qso{}, "Freq", common.ModelToTypeFreqConverter)
+		b.ConverterFor(types.Qso{}, "QsoDate", ModelToTypeDateConverter)
+		b.ConverterFor(types.Qso{}, "TimeOn", ModelToTypeTimeConverter)
+		b.ConverterFor(types.Qso{}, "TimeOff", ModelToTypeTimeConverter)
+		b.ConverterFor(types.Qso{}, "Country", common.ModelToTypeStringConverter)
+	})
+}
@@ -0,0 +1,64 @@
+package sqlite
+
+import (
+	"testing"
+
+	"github.com/Station-Manager/adapters"
+	"github.com/Station-Manager/database/sqlite/models"
+	"github.com/Station-Manager/types"
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func presetQso() types.Qso {
+	return types.Qso{
+		ID:        42,
+		LogbookID: 7,
+		QsoDetails: types.QsoDetails{
+			Band:    "20m",
+			Freq:    "14.320",
+			Mode:    "SSB",
+			QsoDate: "2025-11-08",
+			TimeOn:  "12:34",
+			TimeOff: "13:01",
+			RstSent: "59",
+			RstRcvd: "57",
+			TxPwr:   "100",
+		},
+		ContactedStation: types.ContactedStation{Call: "M0CMC", Country: "England", Name: "Marc"},
+		LoggingStation:   types.LoggingStation{StationCallsign: "G4ABC", MyGridsquare: "IO91"},
+	}
+}
+
+func TestRegisterSQLiteConverters_TypeToModel(t *testing.T) {
+	a := adapters.New()
+	RegisterSQLiteConverters(a)
+	src := presetQso()
+	var dst models.Qso
+	require.NoError(t, a.Into(&dst, &src))
+	assert.Equal(t, int64(42), dst.ID)
+	assert.Equal(t, int64(7), dst.LogbookID)
+	assert.Equal(t, "M0CMC", dst.Call)
+	assert.Equal(t, "20m", dst.Band)
+	assert.Equal(t, "SSB", dst.Mode)
+	assert.Equal(t, int64(14320000), dst.Freq)
+	assert.Equal(t, "20251108", dst.QsoDate)
+	assert.Equal(t, "1234", dst.TimeOn)
+	assert.Equal(t, "1301", dst.TimeOff)
+	assert.Equal(t, "59", dst.RstSent)
+	assert.Equal(t, "57", dst.RstRcvd)
+	assert.Equal(t, null.StringFrom("England"), dst.Country)
+	assert.JSONEq(t, `{"TxPwr":"100","Name":"Marc","StationCallsign":"G4ABC","MyGridsquare":"IO91"}`, string(dst.AdditionalData))
+}
+
+func TestRegisterSQLiteConverters_RoundTrip(t *testing.T) {
+	a := adapters.New()
+	RegisterSQLiteConverters(a)
+	src := presetQso()
+	var model models.Qso
+	require.NoError(t, a.Into(&model, &src))
+	var back types.Qso
+	require.NoError(t, a.Into(&back, &model))
+	assert.Equal(t, src, back)
+}
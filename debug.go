@@ -0,0 +1,40 @@
+package adapters
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// String summarizes the adapter for logs and incident triage: the registry generation, every option value
+// (function options only as set/unset) and the number of registrations per scope. It reads the same atomic
+// snapshots Into uses and is safe for concurrent use. The format is a debug aid, stable enough for snapshot
+// tests but not meant to be parsed, e.g.
+//
+//	Adapter{gen=2 options={IncludeZeroValues=false ...} converters={global=1 dst=1 ...} validators={...} ...}
+func (a *Adapter) String() string {
+	reg := a.converters.Load().(*converterRegistry)
+	vreg := a.validators.Load().(*validatorRegistry)
+	var b strings.Builder
+	fmt.Fprintf(&b, "Adapter{gen=%d options={", a.gen.Load())
+	writeOptions(&b, a.options, " ")
+	fmt.Fprintf(&b, "} converters={global=%d dst=%d pair=%d json=%d context=%d value=%d enums=%d marshalTransforms=%d additionalDataConverters=%d bridges=%d fanouts=%d coalesce=%d groups=%d}",
+		len(reg.global), nestedLen(reg.byDst), nestedLen(reg.byPair), len(reg.byJSON), len(reg.ctx), len(reg.value), len(reg.enums),
+		len(reg.toAD), len(reg.adConv), nestedLen(reg.bridge), len(reg.fanout), len(reg.coalesce), nestedLen(reg.groups))
+	fmt.Fprintf(&b, " validators={global=%d dst=%d pair=%d}", len(vreg.global), nestedLen(vreg.byDst), nestedLen(vreg.byPair))
+	structConvs := 0
+	for _, fns := range a.structConvs.Load().(map[reflect.Type][]StructConverterFunc) {
+		structConvs += len(fns)
+	}
+	fmt.Fprintf(&b, " structConverters=%d factories=%d}", structConvs, len(a.factories.Load().(map[reflect.Type]func() interface{})))
+	return b.String()
+}
+
+// nestedLen counts the entries of all inner maps of a scoped registry.
+func nestedLen[K comparable, V any](m map[K]map[string]V) int {
+	n := 0
+	for _, inner := range m {
+		n += len(inner)
+	}
+	return n
+}
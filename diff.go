@@ -0,0 +1,53 @@
+package adapters
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// FieldChange records a destination field whose value changed during IntoDiff.
+type FieldChange struct {
+	Field string
+	Old   interface{}
+	New   interface{}
+}
+
+// IntoDiff performs Into and reports which destination fields changed, in declaration order.
+// Values are compared with reflect.DeepEqual; slices and maps in Old share backing storage with the
+// value that was in dst before adaptation.
+func (a *Adapter) IntoDiff(dst, src interface{}) ([]FieldChange, error) {
+	dstVal := reflect.ValueOf(dst)
+	if dst == nil || dstVal.Kind() != reflect.Ptr || dstVal.IsNil() || dstVal.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("dst must be a pointer to a struct")
+	}
+	dstVal = dstVal.Elem()
+	meta := a.getOrBuildMetadata(dstVal.Type())
+	before := make([]interface{}, len(meta.fields))
+	present := make([]bool, len(meta.fields))
+	for i := range meta.fields {
+		if f, ok := a.safeFieldByIndex(dstVal, meta.fields[i].index); ok {
+			before[i], present[i] = f.Interface(), true
+		}
+	}
+	if err := a.Into(dst, src); err != nil {
+		return nil, err
+	}
+	var changes []FieldChange
+	for i := range meta.fields {
+		fi := &meta.fields[i]
+		f, ok := a.safeFieldByIndex(dstVal, fi.index)
+		if !ok {
+			continue
+		}
+		after := f.Interface()
+		if present[i] && reflect.DeepEqual(before[i], after) {
+			continue
+		}
+		var old interface{}
+		if present[i] {
+			old = before[i]
+		}
+		changes = append(changes, FieldChange{Field: fi.name, Old: old, New: after})
+	}
+	return changes, nil
+}
@@ -0,0 +1,89 @@
+package adapters
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// EnumOption configures a mapping registered with RegisterEnum.
+type EnumOption func(*enumMapping)
+
+// WithEnumUnknownAsZero makes unknown enum values adapt to the destination zero value instead of erroring.
+func WithEnumUnknownAsZero() EnumOption { return func(e *enumMapping) { e.unknownAsZero = true } }
+
+type enumMapping struct {
+	toModel       map[string]int64
+	toType        map[int64]string
+	unknownAsZero bool
+}
+
+// RegisterEnum registers a bijective string<->integer mapping for a field name. During plan building the direction
+// is chosen from the field kinds: a string source with an integer destination uses toModel, an integer source with
+// a string destination uses the inverted map. Explicitly registered converters for the field take precedence.
+// The field name is matched against the destination field first, then the source field.
+// An error is returned when toModel is not bijective (two names map to the same value).
+func (a *Adapter) RegisterEnum(fieldName string, toModel map[string]int64, opts ...EnumOption) error {
+	e := &enumMapping{toModel: make(map[string]int64, len(toModel)), toType: make(map[int64]string, len(toModel))}
+	for name, id := range toModel {
+		if prev, dup := e.toType[id]; dup {
+			return fmt.Errorf("enum %s is not bijective: %q and %q both map to %d", fieldName, prev, name, id)
+		}
+		e.toModel[name] = id
+		e.toType[id] = name
+	}
+	for _, o := range opts {
+		o(e)
+	}
+	newReg := a.converters.Load().(*converterRegistry).clone()
+	newReg.enums[fieldName] = e
+	a.converters.Store(newReg)
+	a.gen.Add(1)
+	return nil
+}
+
+func isIntKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
+
+// converterFor returns a converter producing values of dt from values of st, or nil when the kinds do not
+// form a string<->integer pair.
+func (e *enumMapping) converterFor(fieldName string, st, dt reflect.Type) ConverterFunc {
+	switch {
+	case st.Kind() == reflect.String && isIntKind(dt.Kind()):
+		return func(src interface{}) (interface{}, error) {
+			name := reflect.ValueOf(src).String()
+			id, ok := e.toModel[name]
+			if !ok {
+				if e.unknownAsZero {
+					return reflect.Zero(dt).Interface(), nil
+				}
+				return nil, fmt.Errorf("unknown %s enum value %q", fieldName, name)
+			}
+			return reflect.ValueOf(id).Convert(dt).Interface(), nil
+		}
+	case isIntKind(st.Kind()) && dt.Kind() == reflect.String:
+		return func(src interface{}) (interface{}, error) {
+			v := reflect.ValueOf(src)
+			var id int64
+			if v.CanInt() {
+				id = v.Int()
+			} else {
+				id = int64(v.Uint())
+			}
+			name, ok := e.toType[id]
+			if !ok {
+				if e.unknownAsZero {
+					return reflect.Zero(dt).Interface(), nil
+				}
+				return nil, fmt.Errorf("unknown %s enum id %d", fieldName, id)
+			}
+			return reflect.ValueOf(name).Convert(dt).Interface(), nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package adapters
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// RegisterFactory sets the constructor MakeWithFactory uses for dstType (an example value or pointer), for
+// types whose zero value is not a valid starting point. fn must return a pointer to dstType; the adaptation
+// then overwrites only the fields it maps, so anything fn pre-populates survives unless the source sets it.
+// Registering a type again replaces its factory. Factories do not affect Into, which always adapts into the
+// destination it is given.
+func (a *Adapter) RegisterFactory(dstType any, fn func() interface{}) {
+	dt := reflect.TypeOf(dstType)
+	if dt.Kind() == reflect.Ptr {
+		dt = dt.Elem()
+	}
+	old := a.factories.Load().(map[reflect.Type]func() interface{})
+	m := make(map[reflect.Type]func() interface{}, len(old)+1)
+	for k, v := range old {
+		m[k] = v
+	}
+	m[dt] = fn
+	a.factories.Store(m)
+}
+
+// MakeWithFactory adapts src into a Dst built by the factory registered for Dst, or a zero Dst when there is
+// none, and returns the pointer the factory produced. It fails if the factory returns anything but a non-nil *Dst.
+func MakeWithFactory[Dst any](a *Adapter, src any) (*Dst, error) {
+	d, err := newFromFactory[Dst](a)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.Into(d, src); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func newFromFactory[Dst any](a *Adapter) (*Dst, error) {
+	dt := reflect.TypeOf((*Dst)(nil)).Elem()
+	fn := a.factories.Load().(map[reflect.Type]func() interface{})[dt]
+	if fn == nil {
+		return new(Dst), nil
+	}
+	v := fn()
+	d, ok := v.(*Dst)
+	if !ok || d == nil {
+		return nil, fmt.Errorf("factory for %s must return a non-nil *%s, got %T", dt, dt, v)
+	}
+	return d, nil
+}
@@ -0,0 +1,45 @@
+package adapters
+
+// RegisterFieldFanout copies the source field srcField into each of dstFields as well as into any destination
+// field it matches by name. Each destination field resolves its own converter and validator by its own name,
+// so the same source value can land in fields of different types. A fanout entry replaces a destination
+// field's regular match. The source field counts as processed, so it is not also marshaled into destination
+// AdditionalData. Registering srcField again replaces its destination list.
+func (a *Adapter) RegisterFieldFanout(srcField string, dstFields ...string) {
+	newReg := a.converters.Load().(*converterRegistry).clone()
+	newReg.fanout[srcField] = append([]string(nil), dstFields...)
+	a.converters.Store(newReg)
+	a.gen.Add(1)
+}
+
+// planFanout adds or replaces plan entries for fanned-out source fields. Unknown, ignored and AdditionalData
+// fields on either side are skipped, as are writeonly sources; a readonly destination only consumes the source.
+func (a *Adapter) planFanout(p *buildPlan, reg *converterRegistry, vreg *validatorRegistry, srcMeta, dstMeta *structMetadata) {
+	byDst := make(map[string]int, len(p.fields))
+	for i := range p.fields {
+		byDst[p.fields[i]._dstName] = i
+	}
+	for _, srcName := range sortedKeys(reg.fanout) {
+		sf := srcMeta.fieldsByName[srcName]
+		if sf == nil || sf.isAdditionalData || sf.ignore || sf.writeonly {
+			continue
+		}
+		for _, dstName := range reg.fanout[srcName] {
+			df := dstMeta.fieldsByName[dstName]
+			if df == nil || !df.canSet || df.isAdditionalData || df.ignore || a.skipDst(df.name) {
+				continue
+			}
+			if df.readonly {
+				p.readonlySrc = append(p.readonlySrc, sf.name)
+				continue
+			}
+			fp := a.planField(reg, vreg, p.srcType, p.dstType, df, sf)
+			if i, ok := byDst[dstName]; ok {
+				p.fields[i] = fp
+				continue
+			}
+			byDst[dstName] = len(p.fields)
+			p.fields = append(p.fields, fp)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package adapters
+
+import "reflect"
+
+// FieldDescriptor is a read-only description of a field the adapter sees on a struct type. Embedded and
+// flattened structs are expanded, so Name is the (prefixed) flattened name and IndexPath the path for
+// reflect.Value.FieldByIndex. JSONName is empty when the field has no json name.
+type FieldDescriptor struct {
+	Name             string
+	JSONName         string
+	Type             reflect.Type
+	IsAdditionalData bool
+	Ignored          bool // adapter:"ignore" or adapter:"-"
+	ReadOnly         bool // adapter:"readonly"
+	WriteOnly        bool // adapter:"writeonly"
+	IndexPath        []int
+}
+
+// EachField calls fn for every adapter-visible field of typ (an example value, a pointer to one, or a
+// reflect.Type) in declaration order, using the adapter's cached metadata. Unexported fields are not
+// visited, and nothing is visited when typ is not a struct. Descriptors are copies, so fn may keep them;
+// EachField is safe for concurrent use.
+func (a *Adapter) EachField(typ any, fn func(FieldDescriptor)) {
+	t, ok := typ.(reflect.Type)
+	if !ok {
+		t = reflect.TypeOf(typ)
+	}
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return
+	}
+	meta := a.getOrBuildMetadata(t)
+	for i := range meta.fields {
+		fi := &meta.fields[i]
+		fn(FieldDescriptor{
+			Name:             fi.name,
+			JSONName:         fi.jsonName,
+			Type:             fi.typ,
+			IsAdditionalData: fi.isAdditionalData,
+			Ignored:          fi.ignore,
+			ReadOnly:         fi.readonly,
+			WriteOnly:        fi.writeonly,
+			IndexPath:        append([]int(nil), fi.index...),
+		})
+	}
+}
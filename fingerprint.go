@@ -0,0 +1,64 @@
+package adapters
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// PlanFingerprint returns a hex SHA-256 identifying how src is adapted into dst (example values or pointers
+// to structs) under the adapter's current registrations and options. The hash covers:
+//   - the source and destination type names;
+//   - every planned field copy: destination and source names and index paths, the registry scope its
+//     converter was resolved from (pair, dst, context, global, json or enum; empty for a direct copy), whether
+//     a validator applies, and whether an unexported-field bridge is used;
+//   - readonly-consumed and unmapped source fields, AdditionalData presence on either side, and the number of
+//     struct converters;
+//   - the registry generation, which advances with every Register* call (even for unrelated fields);
+//   - every Options value, with function-valued options reduced to whether they are set.
+//
+// Function identities are not hashed, so swapping one converter for another in the same scope keeps the
+// fingerprint. It is stable across processes that make the same registrations in the same order, which
+// makes it suitable for golden tests that catch unintended mapping changes.
+func (a *Adapter) PlanFingerprint(src, dst any) (string, error) {
+	st, dt := structType(src), structType(dst)
+	if st == nil || dt == nil {
+		return "", fmt.Errorf("src and dst must be structs or pointers to structs")
+	}
+	if err := metadataErr(a.getOrBuildMetadata(st), a.getOrBuildMetadata(dt)); err != nil {
+		return "", err
+	}
+	p := a.getPlan(st, dt)
+	h := sha256.New()
+	fmt.Fprintf(h, "src=%s\ndst=%s\ngen=%d\n", st, dt, p.gen)
+	for i := range p.fields {
+		fp := &p.fields[i]
+		fmt.Fprintf(h, "field %s%v <- %s%v conv=%s val=%t bridge=%t\n", fp._dstName, fp._dstIndex, fp._srcName, fp._srcIndex,
+			fp.convScope, fp.val != nil, fp.get != nil || fp.set != nil)
+		for _, alt := range fp.alts {
+			fmt.Fprintf(h, "  or %s%v conv=%s\n", alt._srcName, alt._srcIndex, alt.convScope)
+		}
+	}
+	fmt.Fprintf(h, "readonly=%v\nunmapped=%v\nad=%t,%t\nstructconv=%d\n", p.readonlySrc, p.unmappedSrc, p.srcHasAD, p.dstHasAD, len(p.structConv))
+	writeOptions(h, a.options, "\n")
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// writeOptions writes each Options field as name=value, separated by sep, reducing funcs to whether they are set.
+func writeOptions(w io.Writer, o Options, sep string) {
+	v := reflect.ValueOf(o)
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if i > 0 {
+			io.WriteString(w, sep)
+		}
+		f := v.Field(i)
+		if f.Kind() == reflect.Func {
+			fmt.Fprintf(w, "%s=%t", t.Field(i).Name, !f.IsNil())
+			continue
+		}
+		fmt.Fprintf(w, "%s=%v", t.Field(i).Name, f.Interface())
+	}
+}
@@ -0,0 +1,89 @@
+package adapters
+
+// Fluent registration: thin wrappers over the Register* methods that return the adapter, so direct
+// configuration can chain like the Builder, e.g. New().WithConverter("A", f).WithValidator("B", v).
+// Registrations that can fail (RegisterEnum, RegisterNullType, RegisterUnexportedBridge) have no fluent form.
+
+// WithConverter registers a global field converter and returns a.
+func (a *Adapter) WithConverter(fieldName string, fn ConverterFunc) *Adapter {
+	a.RegisterConverter(fieldName, fn)
+	return a
+}
+
+// WithConverterFor registers a converter scoped to a destination type and returns a.
+func (a *Adapter) WithConverterFor(dstType any, fieldName string, fn ConverterFunc) *Adapter {
+	a.RegisterConverterFor(dstType, fieldName, fn)
+	return a
+}
+
+// WithConverterForPair registers a converter scoped to a (src,dst) type pair and returns a.
+func (a *Adapter) WithConverterForPair(srcType, dstType any, fieldName string, fn ConverterFunc) *Adapter {
+	a.RegisterConverterForPair(srcType, dstType, fieldName, fn)
+	return a
+}
+
+// WithContextConverter registers a global context-aware converter and returns a.
+func (a *Adapter) WithContextConverter(fieldName string, fn ContextConverterFunc) *Adapter {
+	a.RegisterContextConverter(fieldName, fn)
+	return a
+}
+
+// WithConverterByJSON registers a converter keyed by destination json name and returns a.
+func (a *Adapter) WithConverterByJSON(jsonName string, fn ConverterFunc) *Adapter {
+	a.RegisterConverterByJSON(jsonName, fn)
+	return a
+}
+
+// WithMarshalTransform registers an AdditionalData marshal transform and returns a.
+func (a *Adapter) WithMarshalTransform(fieldName string, fn MarshalTransformFunc) *Adapter {
+	a.RegisterMarshalTransform(fieldName, fn)
+	return a
+}
+
+// WithAdditionalDataMarshalConverter registers an AdditionalData marshal converter and returns a.
+func (a *Adapter) WithAdditionalDataMarshalConverter(fieldName string, fn ConverterFunc) *Adapter {
+	a.RegisterAdditionalDataMarshalConverter(fieldName, fn)
+	return a
+}
+
+// WithStructConverter registers a whole-struct converter for a destination type and returns a.
+func (a *Adapter) WithStructConverter(dstType any, fn StructConverterFunc) *Adapter {
+	a.RegisterStructConverter(dstType, fn)
+	return a
+}
+
+// WithValidator registers a global field validator and returns a.
+func (a *Adapter) WithValidator(fieldName string, fn ValidatorFunc) *Adapter {
+	a.RegisterValidator(fieldName, fn)
+	return a
+}
+
+// WithValidatorFor registers a validator scoped to a destination type and returns a.
+func (a *Adapter) WithValidatorFor(dstType any, fieldName string, fn ValidatorFunc) *Adapter {
+	a.RegisterValidatorFor(dstType, fieldName, fn)
+	return a
+}
+
+// WithValidatorForPair registers a validator scoped to a (src,dst) type pair and returns a.
+func (a *Adapter) WithValidatorForPair(srcType, dstType any, fieldName string, fn ValidatorFunc) *Adapter {
+	a.RegisterValidatorForPair(srcType, dstType, fieldName, fn)
+	return a
+}
+
+// WithFieldFanout registers a source field fanout and returns a.
+func (a *Adapter) WithFieldFanout(srcField string, dstFields ...string) *Adapter {
+	a.RegisterFieldFanout(srcField, dstFields...)
+	return a
+}
+
+// WithFactory registers a destination factory for MakeWithFactory and returns a.
+func (a *Adapter) WithFactory(dstType any, fn func() interface{}) *Adapter {
+	a.RegisterFactory(dstType, fn)
+	return a
+}
+
+// WithFieldGroup registers a destination field group and returns a.
+func (a *Adapter) WithFieldGroup(dstType any, groupField string, srcFields ...string) *Adapter {
+	a.RegisterFieldGroup(dstType, groupField, srcFields...)
+	return a
+}
@@ -0,0 +1,44 @@
+package adapters
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// IntoFromAdditionalData fills dst purely from src's AdditionalData, for re-expanding extras when the typed
+// source fields are stale but the AdditionalData is authoritative. Only the AdditionalData expansion step of
+// Into runs: no source field is copied, not even a same-named one, nothing is marshaled into destination
+// AdditionalData and struct converters do not run. Since no direct field was written, no destination field is
+// protected by the overwrite policy; ignored, readonly and filtered destination fields are still skipped, and
+// converters apply to decoded values as in Into. The call is explicit, so WithDisableUnmarshalAdditionalData
+// does not apply. It is an error when src has no AdditionalData field.
+func (a *Adapter) IntoFromAdditionalData(dst, src interface{}) error {
+	if src == nil || dst == nil {
+		return fmt.Errorf("src and dst must not be nil")
+	}
+	srcVal := reflect.ValueOf(src)
+	dstVal := reflect.ValueOf(dst)
+	if srcVal.Kind() != reflect.Ptr || dstVal.Kind() != reflect.Ptr {
+		return fmt.Errorf("src and dst must be pointers")
+	}
+	srcVal = srcVal.Elem()
+	dstVal = dstVal.Elem()
+	if srcVal.Kind() != reflect.Struct || dstVal.Kind() != reflect.Struct {
+		return fmt.Errorf("src and dst must point to structs")
+	}
+	dstMeta := a.getOrBuildMetadata(dstVal.Type())
+	srcMeta := a.getOrBuildMetadata(srcVal.Type())
+	if err := metadataErr(dstMeta, srcMeta); err != nil {
+		return err
+	}
+	if srcMeta.additionalDataField == nil {
+		return fmt.Errorf("%s has no AdditionalData field", srcVal.Type())
+	}
+	sc := a.getScratch()
+	defer a.putScratch(sc)
+	srcAD := srcVal.FieldByIndex(srcMeta.additionalDataField.index)
+	if err := a.unmarshalAdditionalData(dstVal, dstMeta, srcAD, sc); err != nil {
+		return adaptError("", fmt.Errorf("unmarshaling AdditionalData: %w", err))
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package adapters
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-json"
+)
+
+var stringMapType = reflect.TypeOf(map[string]string(nil))
+
+// FromMap fills dst (a pointer to struct) from m, the inverse of ToMap for config-like records. Keys match
+// destination fields by Go name, then json name (ignoring case with WithCaseInsensitiveAdditionalData), and
+// values are parsed into the field's kind: strings, bools, ints, uints, floats and encoding.TextUnmarshaler
+// types such as time.Time. A converter registered for the field (for dst's type, globally or by json name)
+// receives the string instead and must return the field's type. Validators run after each assignment.
+// Ignored, readonly and filtered-out fields are not written. Keys that match no field are stored in dst's
+// AdditionalData when it has one (replacing it; left untouched when every key matched); otherwise they are
+// dropped, or reported when ErrorOnUnmappedSource is set. An unparsable value fails FromMap.
+func (a *Adapter) FromMap(dst interface{}, m map[string]string) error {
+	if dst == nil {
+		return fmt.Errorf("dst must not be nil")
+	}
+	dstVal := reflect.ValueOf(dst)
+	if dstVal.Kind() != reflect.Ptr || dstVal.IsNil() || dstVal.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("dst must be a non-nil pointer to struct")
+	}
+	dstVal = dstVal.Elem()
+	dt := dstVal.Type()
+	meta := a.getOrBuildMetadata(dt)
+	if err := metadataErr(meta); err != nil {
+		return err
+	}
+	reg := a.converters.Load().(*converterRegistry)
+	var unknown map[string]interface{}
+	for _, k := range sortedKeys(m) {
+		fi := meta.lookupKey(k, a.options.CaseInsensitiveAdditionalData)
+		if fi == nil || fi.isAdditionalData {
+			if unknown == nil {
+				unknown = make(map[string]interface{})
+			}
+			unknown[k] = m[k]
+			continue
+		}
+		if !fi.canSet || fi.ignore || fi.readonly || a.skipDst(fi.name) {
+			continue
+		}
+		dstField, ok := a.fieldByIndexAlloc(dstVal, fi.index)
+		if !ok {
+			continue
+		}
+		if fn := fromMapConverter(reg, dt, fi); fn != nil {
+			converted, err := fn(m[k])
+			if err == nil {
+				err = a.setConverted(dstField, converted, fi.name)
+			} else {
+				err = converterError(fi.name, m[k], err)
+			}
+			if err != nil {
+				return fmt.Errorf("adapting field %s: %w", fi.name, err)
+			}
+		} else if err := parseStringInto(dstField, m[k]); err != nil {
+			return fmt.Errorf("adapting field %s: %w", fi.name, err)
+		}
+		if err := a.runValidators(dstField, fi.name, stringMapType, dt); err != nil {
+			return err
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	if meta.additionalDataField == nil || a.options.DisableMarshalAdditionalData {
+		if a.options.ErrorOnUnmappedSource {
+			return fmt.Errorf("unmapped keys for %s: %s", dt, strings.Join(sortedKeys(unknown), ", "))
+		}
+		return nil
+	}
+	b, err := json.Marshal(unknown)
+	if err != nil {
+		return fmt.Errorf("marshaling unknown keys to AdditionalData: %w", err)
+	}
+	storeAdditionalData(dstVal.FieldByIndex(meta.additionalDataField.index), b)
+	return nil
+}
+
+// fromMapConverter resolves fi's converter by destination type, then globally, then by json name.
+func fromMapConverter(reg *converterRegistry, dt reflect.Type, fi *fieldInfo) ConverterFunc {
+	if fn := reg.byDst[dt][fi.name]; fn != nil {
+		return fn
+	}
+	if fn := reg.global[fi.name]; fn != nil {
+		return fn
+	}
+	if fi.jsonName != "" {
+		return reg.byJSON[fi.jsonName]
+	}
+	return nil
+}
+
+// parseStringInto parses s into v according to v's kind.
+func parseStringInto(v reflect.Value, s string) error {
+	if v.CanAddr() {
+		if tu, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+			return tu.UnmarshalText([]byte(s))
+		}
+	}
+	k := v.Kind()
+	switch {
+	case k == reflect.String:
+		v.SetString(s)
+	case k == reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case isSignedKind(k):
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case isUnsignedKind(k):
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case isFloatKind(k):
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("cannot parse a string into %s", v.Type())
+	}
+	return nil
+}
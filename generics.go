@@ -1,5 +1,12 @@
 package adapters
 
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/goccy/go-json"
+)
+
 // Generic helpers as top-level functions (methods cannot have type parameters yet)
 
 func Copy[T any](a *Adapter, dst *T, src any) error { return a.Into(dst, src) }
@@ -12,8 +19,57 @@ func AdaptTo[T any](a *Adapter, src any) (*T, error) {
 	return &d, nil
 }
 
+// Make adapts src into a new T and returns it by value. T may be an anonymous struct type; registrations scoped
+// to it apply only when made with an identical struct type (same field names, types, tags and order).
 func Make[T any](a *Adapter, src any) (T, error) {
 	var d T
 	err := a.Into(&d, src)
 	return d, err
 }
+
+// JSONRoundTrip marshals in to JSON and unmarshals the result into out, using the same JSON codec as the
+// AdditionalData handling. It is meant for explicit mappers of nested types whose JSON shapes line up.
+//
+// The round trip is lossy: unexported fields, fields tagged json:"-" and fields without a counterpart in Out
+// are dropped silently; omitempty drops zero values; numbers decoded into interface{} become float64; and
+// time.Time keeps only what its RFC3339Nano form carries (monotonic clock readings are lost). Prefer the
+// adapter itself, or field-by-field code, when any of that matters.
+func JSONRoundTrip[In, Out any](in In, out *Out) error {
+	if out == nil {
+		return fmt.Errorf("out must not be nil")
+	}
+	b, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("marshaling %T: %w", in, err)
+	}
+	if err := json.Unmarshal(b, out); err != nil {
+		return fmt.Errorf("unmarshaling into %T: %w", out, err)
+	}
+	return nil
+}
+
+// TypedConverter wraps a strongly typed function as a ConverterFunc, doing the type assertion once instead of
+// in every converter:
+//
+//	a.RegisterConverter("Freq", adapters.TypedConverter(func(hz int64) (string, error) { ... }))
+//
+// A value that is not an In fails with an error naming both types. A nil value is passed as In's zero value
+// when In is a pointer, interface, map, slice, func or chan type, and is a mismatch otherwise.
+func TypedConverter[In, Out any](fn func(In) (Out, error)) ConverterFunc {
+	return func(v interface{}) (interface{}, error) {
+		in, ok := v.(In)
+		if !ok && (v != nil || !nilable(reflect.TypeOf((*In)(nil)).Elem())) {
+			return nil, fmt.Errorf("converter expects %s, got %T", reflect.TypeOf((*In)(nil)).Elem(), v)
+		}
+		return fn(in)
+	}
+}
+
+// nilable reports whether nil is a valid value of t.
+func nilable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return true
+	}
+	return false
+}
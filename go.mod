@@ -10,6 +10,7 @@ require (
 	github.com/aarondl/sqlboiler/v4 v4.19.7
 	github.com/goccy/go-json v0.10.5
 	github.com/stretchr/testify v1.11.1
+	golang.org/x/text v0.31.0
 )
 
 require (
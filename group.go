@@ -0,0 +1,61 @@
+package adapters
+
+import "reflect"
+
+// RegisterFieldGroup adapts the named source fields into the struct (or pointer-to-struct) field groupField
+// of dstType, the reverse of adapter:"flatten": a flat row's Band and Mode can fill Details.Band and
+// Details.Mode. Each source field goes to the group's field of the same Go name and resolves its converter and
+// validator as if the group's type were the destination, so RegisterConverterFor(QsoDetails{}, "Freq", f)
+// applies. A nil group pointer is allocated when one of its fields is written. Grouped source fields still
+// fill same-named top-level destination fields and are not marshaled into destination AdditionalData.
+// Registering the same group again replaces its source list.
+func (a *Adapter) RegisterFieldGroup(dstType any, groupField string, srcFields ...string) {
+	dt := reflect.TypeOf(dstType)
+	if dt.Kind() == reflect.Ptr {
+		dt = dt.Elem()
+	}
+	newReg := a.converters.Load().(*converterRegistry).clone()
+	m := newReg.groups[dt]
+	if m == nil {
+		m = make(map[string][]string, 1)
+		newReg.groups[dt] = m
+	}
+	m[groupField] = append([]string(nil), srcFields...)
+	a.converters.Store(newReg)
+	a.gen.Add(1)
+}
+
+// planGroups appends plan entries that write grouped source fields through their group field. Unknown,
+// ignored, filtered and AdditionalData fields are skipped on either side, as are writeonly sources and
+// readonly group members; a group field that is not a struct or pointer to one is ignored.
+func (a *Adapter) planGroups(p *buildPlan, groups map[string][]string, reg *converterRegistry, vreg *validatorRegistry, srcMeta, dstMeta *structMetadata) {
+	for _, groupName := range sortedKeys(groups) {
+		gf := dstMeta.fieldsByName[groupName]
+		if gf == nil || !gf.canSet || gf.isAdditionalData || gf.ignore || gf.readonly || a.skipDst(gf.name) {
+			continue
+		}
+		gt := gf.typ
+		if gt.Kind() == reflect.Ptr {
+			gt = gt.Elem()
+		}
+		if gt.Kind() != reflect.Struct {
+			continue
+		}
+		groupMeta := a.getOrBuildMetadata(gt)
+		for _, srcName := range groups[groupName] {
+			sf := srcMeta.fieldsByName[srcName]
+			if sf == nil || sf.isAdditionalData || sf.ignore || sf.writeonly {
+				continue
+			}
+			member := groupMeta.fieldsByName[srcName]
+			if member == nil || !member.canSet || member.isAdditionalData || member.ignore || member.readonly {
+				continue
+			}
+			fp := a.planField(reg, vreg, p.srcType, gt, member, sf)
+			fp._dstIndex = append(append([]int(nil), gf.index...), member.index...)
+			fp._dstFlat = false
+			fp._dstName = gf.name + "." + member.name
+			p.fields = append(p.fields, fp)
+		}
+	}
+}
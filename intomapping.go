@@ -0,0 +1,83 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// IntoWithMapping adapts src into dst like Into, but fills each destination field named in mapping
+// (dstField -> srcField, Go names) from the given source field instead of its name match, for this call only;
+// nothing is registered. Unlisted fields are matched by name as usual. A mapped source field is consumed, so
+// it does not land in destination AdditionalData, while the source field the destination would otherwise have
+// matched becomes unmatched. Converters and validators resolve by the destination field's name. A mapping entry
+// naming an unknown or AdditionalData field on either side is an error; entries for ignored, filtered or
+// readonly destination fields, or ignored or writeonly source fields, are skipped like name matches are.
+func (a *Adapter) IntoWithMapping(dst, src interface{}, mapping map[string]string) error {
+	if src == nil || dst == nil {
+		return fmt.Errorf("src and dst must not be nil")
+	}
+	srcVal := reflect.ValueOf(src)
+	dstVal := reflect.ValueOf(dst)
+	if srcVal.Kind() != reflect.Ptr || dstVal.Kind() != reflect.Ptr {
+		return fmt.Errorf("src and dst must be pointers")
+	}
+	srcVal = srcVal.Elem()
+	dstVal = dstVal.Elem()
+	if srcVal.Kind() != reflect.Struct || dstVal.Kind() != reflect.Struct {
+		return fmt.Errorf("src and dst must point to structs")
+	}
+	st, dt := srcVal.Type(), dstVal.Type()
+	dstMeta := a.getOrBuildMetadata(dt)
+	srcMeta := a.getOrBuildMetadata(st)
+	if err := metadataErr(dstMeta, srcMeta); err != nil {
+		return err
+	}
+	for _, dstName := range sortedKeys(mapping) {
+		if df := dstMeta.fieldsByName[dstName]; df == nil || df.isAdditionalData {
+			return fmt.Errorf("mapping %s <- %s: no field %s in %s", dstName, mapping[dstName], dstName, dt)
+		}
+		if sf := srcMeta.fieldsByName[mapping[dstName]]; sf == nil || sf.isAdditionalData {
+			return fmt.Errorf("mapping %s <- %s: no field %s in %s", dstName, mapping[dstName], mapping[dstName], st)
+		}
+	}
+	if len(mapping) == 0 {
+		return a.adaptStructCtx(context.Background(), dstVal, srcVal)
+	}
+	plan := *a.getPlan(st, dt)
+	a.applyMapping(&plan, mapping, srcMeta, dstMeta)
+	return a.runPlan(context.Background(), dstVal, srcVal, &plan, dstMeta, srcMeta)
+}
+
+// applyMapping rewrites p, a copy of a cached plan, so the destination fields in mapping are filled from the
+// mapped source fields. p.fields is reallocated, never modified in place.
+func (a *Adapter) applyMapping(p *buildPlan, mapping map[string]string, srcMeta, dstMeta *structMetadata) {
+	reg := a.converters.Load().(*converterRegistry)
+	vreg := a.validators.Load().(*validatorRegistry)
+	fields := make([]fieldPlan, 0, len(p.fields)+len(mapping))
+	for _, fp := range p.fields {
+		if _, ok := mapping[fp._dstName]; !ok {
+			fields = append(fields, fp)
+		}
+	}
+	readonlySrc := append([]string(nil), p.readonlySrc...)
+	for _, dstName := range sortedKeys(mapping) {
+		df, sf := dstMeta.fieldsByName[dstName], srcMeta.fieldsByName[mapping[dstName]]
+		if !df.canSet || df.ignore || a.skipDst(df.name) || sf.ignore || sf.writeonly {
+			continue
+		}
+		if df.readonly {
+			readonlySrc = append(readonlySrc, sf.name)
+			continue
+		}
+		fields = append(fields, a.planField(reg, vreg, p.srcType, p.dstType, df, sf))
+	}
+	p.fields, p.readonlySrc = fields, readonlySrc
+	if a.options.ErrorOnUnmappedSource && !(p.dstHasAD && !a.options.DisableMarshalAdditionalData) {
+		p.unmappedSrc = unmappedSources(p, srcMeta)
+	}
+	if a.options.ParallelThreshold > 0 {
+		p.parallelSafe = parallelSafe(p.fields)
+	}
+	p.simple = simpleCopies(p)
+}
@@ -0,0 +1,72 @@
+package adapters
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/goccy/go-json"
+)
+
+var byteSliceType = reflect.TypeOf([]byte(nil))
+
+// isJSONText reports whether t holds JSON text: a string or []byte kind.
+func isJSONText(t reflect.Type) bool {
+	return t.Kind() == reflect.String || (t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8)
+}
+
+// isJSONValue reports whether t is serialized to a JSON document by WithStructToJSONField: a struct, map,
+// slice or array (other than JSON text), or a pointer to one.
+func isJSONValue(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Array:
+		return true
+	case reflect.Slice:
+		return !isJSONText(t)
+	}
+	return false
+}
+
+// isJSONFieldPair reports whether WithStructToJSONField applies to copying a field of type st into dt.
+func isJSONFieldPair(st, dt reflect.Type) bool {
+	return (isJSONValue(st) && isJSONText(dt)) || (isJSONText(st) && isJSONValue(dt))
+}
+
+// assignJSONField serializes a structured source into a JSON text destination, or deserializes JSON text
+// into a structured destination. A nil source, or empty text, sets the destination to its zero value.
+func assignJSONField(dstField, srcField reflect.Value) error {
+	if isJSONText(dstField.Type()) {
+		if (srcField.Kind() == reflect.Ptr || srcField.Kind() == reflect.Map || srcField.Kind() == reflect.Slice) && srcField.IsNil() {
+			dstField.Set(reflect.Zero(dstField.Type()))
+			return nil
+		}
+		b, err := json.Marshal(srcField.Interface())
+		if err != nil {
+			return fmt.Errorf("encoding JSON: %w", err)
+		}
+		if dstField.Kind() == reflect.String {
+			dstField.SetString(string(b))
+		} else {
+			dstField.Set(reflect.ValueOf(b).Convert(dstField.Type()))
+		}
+		return nil
+	}
+	var text []byte
+	if srcField.Kind() == reflect.String {
+		text = []byte(srcField.String())
+	} else {
+		text = srcField.Convert(byteSliceType).Bytes()
+	}
+	if len(text) == 0 {
+		dstField.Set(reflect.Zero(dstField.Type()))
+		return nil
+	}
+	ptr := reflect.New(dstField.Type())
+	if err := json.Unmarshal(text, ptr.Interface()); err != nil {
+		return fmt.Errorf("decoding JSON: %w", err)
+	}
+	dstField.Set(ptr.Elem())
+	return nil
+}
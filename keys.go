@@ -0,0 +1,37 @@
+package adapters
+
+import "strings"
+
+// SnakeCaseKey encodes a Go field name as a snake_case AdditionalData key (TxPwr → tx_pwr, QSOID → qsoid,
+// ContactedOpID → contacted_op_id), for use with WithAdditionalDataKeyTransform.
+func SnakeCaseKey(name string) string { return toSnakeCase(name) }
+
+// FromSnakeCaseKey decodes a snake_case AdditionalData key into a Go-style field name (tx_pwr → TxPwr).
+func FromSnakeCaseKey(key string) string { return fromSeparated(key, '_') }
+
+// KebabCaseKey encodes a Go field name as a kebab-case AdditionalData key (TxPwr → tx-pwr).
+func KebabCaseKey(name string) string { return strings.ReplaceAll(toSnakeCase(name), "_", "-") }
+
+// FromKebabCaseKey decodes a kebab-case AdditionalData key into a Go-style field name (tx-pwr → TxPwr).
+func FromKebabCaseKey(key string) string { return fromSeparated(key, '-') }
+
+// fromSeparated joins the sep-separated words of key, upper-casing the first ASCII letter of each word.
+// Acronyms come back title-cased (id → Id); decoded keys are matched ignoring case as a fallback.
+func fromSeparated(key string, sep byte) string {
+	var b strings.Builder
+	b.Grow(len(key))
+	upper := true
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		if c == sep {
+			upper = true
+			continue
+		}
+		if upper && c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		upper = false
+		b.WriteByte(c)
+	}
+	return b.String()
+}
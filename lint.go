@@ -0,0 +1,87 @@
+package adapters
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// CheckStruct reports misconfigured adapter tags on t (a struct type or pointer to one) and the structs it
+// flattens: unknown adapter tag values (e.g. a mistyped "ignor"), empty prefix=/adpath= values, prefix= or
+// flatten on fields that are not flattened structs, "additional" on fields that are not null.JSON or
+// types.JSON (or pointers to them), and more than one AdditionalData field. Unexported fields are skipped like at adapt time.
+// It does not need an Adapter and is meant for tests or CI, e.g. require.Empty(t, adapters.CheckStruct(typ)).
+func CheckStruct(t reflect.Type) []error {
+	if t == nil {
+		return []error{fmt.Errorf("CheckStruct: nil type")}
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return []error{fmt.Errorf("CheckStruct: %s is not a struct", t)}
+	}
+	var errs []error
+	var adFields []string
+	checkFields(t, t, "", nil, &errs, &adFields)
+	if len(adFields) > 1 {
+		errs = append(errs, fmt.Errorf("struct %s: multiple AdditionalData fields: %s", t, strings.Join(adFields, ", ")))
+	}
+	return errs
+}
+
+// checkFields walks typ the way buildFieldMetadata does, appending problems to errs and the paths of
+// AdditionalData fields to adFields. path is the dotted Go path of typ within root.
+func checkFields(root, typ reflect.Type, path string, visiting []reflect.Type, errs *[]error, adFields *[]string) {
+	visiting = append(visiting, typ)
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		name := path + f.Name
+		tag, hasTag := f.Tag.Lookup("adapter")
+		fail := func(format string, args ...any) {
+			*errs = append(*errs, fmt.Errorf("struct %s: field %s: "+format, append([]any{root, name}, args...)...))
+		}
+		if inlined(f) {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				if p, ok := strings.CutPrefix(tag, "prefix="); ok && p == "" {
+					fail("empty adapter prefix")
+				} else if !ok && hasTag && tag != "flatten" {
+					fail("adapter tag %q has no effect on an embedded struct", tag)
+				}
+				if !containsType(visiting, ft) {
+					checkFields(root, ft, name+".", visiting, errs, adFields)
+				}
+				continue
+			}
+		}
+		if f.PkgPath != "" || !hasTag && f.Name != "AdditionalData" {
+			continue
+		}
+		adTag, adOpt, hasOpt := strings.Cut(tag, ",")
+		switch {
+		case tag == "", tag == "ignore", tag == "-", tag == "readonly", tag == "writeonly", tag == "includezero", tag == "omitzero":
+		case tag == "flatten":
+			fail("adapter:\"flatten\" needs a struct or pointer to struct, got %s", f.Type)
+		case strings.HasPrefix(tag, "prefix="):
+			fail("adapter:\"prefix=...\" only applies to embedded or flattened structs")
+		case strings.HasPrefix(tag, "adpath="):
+			if p := strings.TrimPrefix(tag, "adpath="); p == "" || strings.HasPrefix(p, ".") || strings.HasSuffix(p, ".") || strings.Contains(p, "..") {
+				fail("invalid adapter path %q", p)
+			}
+		case adTag == "additional" && (!hasOpt || adOpt == "omitempty"):
+		default:
+			fail("unknown adapter tag %q", tag)
+		}
+		if adTag == "additional" || f.Name == "AdditionalData" {
+			if isADType(f.Type) {
+				*adFields = append(*adFields, name)
+			} else if adTag == "additional" {
+				fail("adapter:\"additional\" needs null.JSON or types.JSON (or a pointer to one), got %s", f.Type)
+			}
+		}
+	}
+}
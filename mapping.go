@@ -0,0 +1,121 @@
+package adapters
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// MappingReport is the result of a static CheckMapping analysis between a source and destination type.
+type MappingReport struct {
+	Matched          []string // destination fields populated from a source field
+	Incompatible     []string // destination fields matched by name but with no converter and incompatible types
+	SourceOnly       []string // source fields with no destination field and no AdditionalData sink (dropped)
+	ToAdditionalData []string // source fields with no destination field, marshaled into destination AdditionalData
+	DestinationOnly  []string // destination fields with no source field (may still be filled from source AdditionalData)
+	Err              error    // set when either type is not a struct or its metadata is invalid
+}
+
+// Clean reports whether every source field lands somewhere and every destination field has a compatible source.
+func (r MappingReport) Clean() bool {
+	return r.Err == nil && len(r.Incompatible) == 0 && len(r.SourceOnly) == 0 && len(r.DestinationOnly) == 0
+}
+
+// CheckMapping statically analyzes how src maps onto dst using cached metadata and the current registries.
+// src and dst are example values or pointers; no adaptation is performed. Ignored fields are excluded,
+// readonly destination fields are never reported as destination-only, writeonly source fields are never
+// reported as source-only, and
+// AdditionalData fields are treated as a sink/source rather than regular fields. Intended for tests that
+// guard against schema drift between models and types.
+func (a *Adapter) CheckMapping(src, dst any) MappingReport {
+	st, dt := reflect.TypeOf(src), reflect.TypeOf(dst)
+	if st == nil || dt == nil {
+		return MappingReport{Err: fmt.Errorf("src and dst must not be nil")}
+	}
+	if st.Kind() == reflect.Ptr {
+		st = st.Elem()
+	}
+	if dt.Kind() == reflect.Ptr {
+		dt = dt.Elem()
+	}
+	if st.Kind() != reflect.Struct || dt.Kind() != reflect.Struct {
+		return MappingReport{Err: fmt.Errorf("src and dst must be structs")}
+	}
+	srcMeta := a.getOrBuildMetadata(st)
+	dstMeta := a.getOrBuildMetadata(dt)
+	if err := metadataErr(dstMeta, srcMeta); err != nil {
+		return MappingReport{Err: err}
+	}
+	plan := a.getPlan(st, dt)
+	var r MappingReport
+	matchedSrc := make(map[string]bool, len(plan.fields))
+	matchedDst := make(map[string]bool, len(plan.fields))
+	for i := range plan.fields {
+		fp := &plan.fields[i]
+		matchedSrc[fp._srcName] = true
+		matchedDst[fp._dstName] = true
+		for _, alt := range fp.alts {
+			matchedSrc[alt._srcName] = true
+		}
+		bridged := fp.get != nil || fp.set != nil // accessor types are opaque; trust the user
+		if !bridged && fp.conv == nil && fp.ctxConv == nil && fp.valConv == nil && !a.directCompatible(srcMeta.fieldsByName[fp._srcName].typ, dstMeta.fieldsByName[fp._dstName].typ) {
+			r.Incompatible = append(r.Incompatible, fp._dstName)
+			continue
+		}
+		r.Matched = append(r.Matched, fp._dstName)
+	}
+	for _, name := range plan.readonlySrc {
+		matchedSrc[name] = true
+	}
+	sink := plan.dstHasAD && !a.options.DisableMarshalAdditionalData
+	for i := range srcMeta.fields {
+		sf := &srcMeta.fields[i]
+		if sf.isAdditionalData || sf.ignore || sf.writeonly || matchedSrc[sf.name] {
+			continue
+		}
+		if sink && !a.hiddenFromAD(sf) {
+			r.ToAdditionalData = append(r.ToAdditionalData, sf.name)
+		} else {
+			r.SourceOnly = append(r.SourceOnly, sf.name)
+		}
+	}
+	for i := range dstMeta.fields {
+		df := &dstMeta.fields[i]
+		if df.isAdditionalData || df.ignore || df.readonly || a.skipDst(df.name) || matchedDst[df.name] {
+			continue
+		}
+		r.DestinationOnly = append(r.DestinationOnly, df.name)
+	}
+	return r
+}
+
+// directCompatible mirrors the runtime direct-copy rules in adaptStruct at the type level.
+func (a *Adapter) directCompatible(st, dt reflect.Type) bool {
+	if st.AssignableTo(dt) {
+		return true
+	}
+	if a.options.AllowImplicitConvert {
+		if isArrayPair(st, dt) {
+			if st.Elem().AssignableTo(dt.Elem()) || convertibleKind(st.Elem(), dt.Elem()) {
+				return true
+			}
+		} else if st.ConvertibleTo(dt) {
+			return true
+		}
+	}
+	if a.options.NullAware && a.nullAwareCompatible(st, dt) {
+		return true
+	}
+	if a.options.AutoPointers && a.isPointerPair(st, dt) {
+		return true
+	}
+	if a.options.NestedStructs && (isNestedPair(st, dt) || isNestedSlicePair(st, dt)) {
+		return true
+	}
+	if a.options.StructToJSONField && isJSONFieldPair(st, dt) {
+		return true
+	}
+	if a.options.DynamicInterfaceSources && st.Kind() == reflect.Interface {
+		return true // decided by the dynamic value at adapt time
+	}
+	return a.options.FallbackConverter != nil
+}
@@ -0,0 +1,81 @@
+package adapters
+
+import "reflect"
+
+// FieldInfo is a read-only view of a struct field as seen by the adapter. Name and JSONName include any
+// adapter:"prefix=..." of enclosing embedded structs; JSONName is empty when the field has no json name.
+type FieldInfo struct {
+	Name     string
+	JSONName string
+	Type     reflect.Type
+	Tag      reflect.StructTag
+}
+
+// SourceFields gives a FieldMatcher access to the fields of the source struct. AdditionalData fields are
+// not exposed.
+type SourceFields struct {
+	meta *structMetadata
+}
+
+// ByName returns the source field with the given Go name.
+func (s SourceFields) ByName(name string) (FieldInfo, bool) {
+	return viewOf(s.meta.fieldsByName[name])
+}
+
+// ByJSONName returns the source field with the given json name.
+func (s SourceFields) ByJSONName(jsonName string) (FieldInfo, bool) {
+	return viewOf(s.meta.fieldsByJSONName[jsonName])
+}
+
+// All returns every source field in declaration order.
+func (s SourceFields) All() []FieldInfo {
+	out := make([]FieldInfo, 0, len(s.meta.fields))
+	for i := range s.meta.fields {
+		if fi, ok := viewOf(&s.meta.fields[i]); ok {
+			out = append(out, fi)
+		}
+	}
+	return out
+}
+
+func viewOf(fi *fieldInfo) (FieldInfo, bool) {
+	if fi == nil || fi.isAdditionalData {
+		return FieldInfo{}, false
+	}
+	return FieldInfo{Name: fi.name, JSONName: fi.jsonName, Type: fi.typ, Tag: fi.tag}, true
+}
+
+// FieldMatcher resolves the source field that fills dst, returning false when there is none. It runs once per
+// destination field when a plan is built, not on every Into. The returned field must come from src; ignored
+// and writeonly source fields are still rejected after matching.
+type FieldMatcher func(dst FieldInfo, src SourceFields) (FieldInfo, bool)
+
+// DefaultMatcher matches by Go field name, then by json name.
+func DefaultMatcher(dst FieldInfo, src SourceFields) (FieldInfo, bool) {
+	if sf, ok := src.ByName(dst.Name); ok {
+		return sf, true
+	}
+	if dst.JSONName != "" {
+		return src.ByJSONName(dst.JSONName)
+	}
+	return FieldInfo{}, false
+}
+
+// matchSource resolves the source field for df using the configured FieldMatcher.
+func (a *Adapter) matchSource(df *fieldInfo, srcMeta *structMetadata) (*fieldInfo, bool) {
+	m := a.options.FieldMatcher
+	if m == nil {
+		sf, found := srcMeta.fieldsByName[df.name]
+		if !found && df.jsonName != "" {
+			sf, found = srcMeta.fieldsByJSONName[df.jsonName]
+		}
+		return sf, found
+	}
+	dv, _ := viewOf(df)
+	sv, ok := m(dv, SourceFields{meta: srcMeta})
+	if !ok {
+		return nil, false
+	}
+	sf, found := srcMeta.fieldsByName[sv.Name]
+	return sf, found && !sf.isAdditionalData
+}
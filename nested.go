@@ -0,0 +1,97 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// isNestedPair reports whether st and dt are both structs or pointers to structs, so a field of type st can be
+// adapted into a field of type dt with WithNestedStructs.
+func isNestedPair(st, dt reflect.Type) bool {
+	if st.Kind() == reflect.Ptr {
+		st = st.Elem()
+	}
+	if dt.Kind() == reflect.Ptr {
+		dt = dt.Elem()
+	}
+	return st.Kind() == reflect.Struct && dt.Kind() == reflect.Struct
+}
+
+// assignNested adapts a struct or *struct source field into a struct or *struct destination field of a
+// different type. A nil or entirely zero source leaves a nil pointer (or zero struct) in the destination.
+// Otherwise a pointer destination gets a freshly allocated struct, seeded from the struct it already points
+// to so unmapped fields survive without mutating a value that may be shared.
+func (a *Adapter) assignNested(ctx context.Context, dstField, srcField reflect.Value) error {
+	sv := srcField
+	if sv.Kind() == reflect.Ptr {
+		if sv.IsNil() {
+			sv = reflect.Value{}
+		} else {
+			sv = sv.Elem()
+		}
+	}
+	if !sv.IsValid() || sv.IsZero() {
+		dstField.Set(reflect.Zero(dstField.Type()))
+		return nil
+	}
+	if dstField.Kind() != reflect.Ptr {
+		return a.adaptStructCtx(ctx, dstField, sv)
+	}
+	n := reflect.New(dstField.Type().Elem())
+	if !dstField.IsNil() {
+		n.Elem().Set(dstField.Elem())
+	}
+	if err := a.adaptStructCtx(ctx, n.Elem(), sv); err != nil {
+		return err
+	}
+	dstField.Set(n)
+	return nil
+}
+
+// isNestedSlicePair reports whether st and dt are slices (st may also be an array) whose elements form a
+// nested pair, so []SrcItem can be adapted element by element into []DstItem with WithNestedStructs.
+func isNestedSlicePair(st, dt reflect.Type) bool {
+	sk := st.Kind()
+	return (sk == reflect.Slice || sk == reflect.Array) && dt.Kind() == reflect.Slice && isNestedPair(st.Elem(), dt.Elem())
+}
+
+// assignNestedSlice adapts each element of a slice (or array) of structs or struct pointers into a new
+// destination slice of the same length, following assignNested per element. A nil source slice sets a nil
+// destination. Element errors name the index.
+func (a *Adapter) assignNestedSlice(ctx context.Context, dstField, srcField reflect.Value) error {
+	if srcField.Kind() == reflect.Slice && srcField.IsNil() {
+		dstField.Set(reflect.Zero(dstField.Type()))
+		return nil
+	}
+	n := srcField.Len()
+	out := reflect.MakeSlice(dstField.Type(), n, n)
+	for i := 0; i < n; i++ {
+		if err := a.assignNested(ctx, out.Index(i), srcField.Index(i)); err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+	dstField.Set(out)
+	return nil
+}
+
+// assignDynamic adapts the value held by the interface srcField into dstField (see WithDynamicInterfaceSources).
+func (a *Adapter) assignDynamic(ctx context.Context, dstField, srcField reflect.Value, fieldName string) (fieldOutcome, error) {
+	if srcField.IsNil() {
+		dstField.Set(reflect.Zero(dstField.Type()))
+		return fieldCopied, nil
+	}
+	v := srcField.Elem()
+	dt := dstField.Type()
+	switch {
+	case v.Type().AssignableTo(dt):
+		dstField.Set(v)
+	case isNestedPair(v.Type(), dt):
+		if err := a.assignNested(ctx, dstField, v); err != nil {
+			return fieldUnreached, err
+		}
+	default:
+		return a.assignIncompatible(dstField, v, fieldName)
+	}
+	return fieldCopied, nil
+}
@@ -0,0 +1,182 @@
+package adapters
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/aarondl/null/v8"
+)
+
+// nullTypeInfo describes a nullable wrapper struct: the index of the field holding the value
+// and the index of the bool field reporting validity.
+type nullTypeInfo struct {
+	valueIndex int
+	validIndex int
+	valueType  reflect.Type
+}
+
+// builtinNullTypes lists the aarondl/null wrappers understood when NullAware is enabled.
+var builtinNullTypes = func() map[reflect.Type]nullTypeInfo {
+	m := make(map[reflect.Type]nullTypeInfo)
+	for _, v := range []any{
+		null.String{}, null.Bool{}, null.Time{},
+		null.Int{}, null.Int8{}, null.Int16{}, null.Int32{}, null.Int64{},
+		null.Uint{}, null.Uint8{}, null.Uint16{}, null.Uint32{}, null.Uint64{},
+		null.Float32{}, null.Float64{}, null.Byte{},
+	} {
+		t := reflect.TypeOf(v)
+		m[t] = nullTypeInfo{valueIndex: 0, validIndex: 1, valueType: t.Field(0).Type}
+	}
+	return m
+}()
+
+// lookupNullType resolves t against the builtin wrappers, explicitly registered wrappers and finally
+// the sql.Null* shape (a two-field struct with a `Valid bool` field). Results are cached per adapter.
+func (a *Adapter) lookupNullType(t reflect.Type) (nullTypeInfo, bool) {
+	if info, ok := builtinNullTypes[t]; ok {
+		return info, true
+	}
+	if t.Kind() != reflect.Struct {
+		return nullTypeInfo{}, false
+	}
+	if v, ok := a.nullTypes.Load(t); ok {
+		info, isNull := v.(nullTypeInfo)
+		return info, isNull
+	}
+	info, ok := detectNullShape(t)
+	if !ok {
+		a.nullTypes.Store(t, false)
+		return nullTypeInfo{}, false
+	}
+	a.nullTypes.Store(t, info)
+	return info, true
+}
+
+// detectNullShape recognizes wrappers shaped like database/sql's Null* family: exactly two exported
+// fields, one of them `Valid bool`.
+func detectNullShape(t reflect.Type) (nullTypeInfo, bool) {
+	if t.NumField() != 2 {
+		return nullTypeInfo{}, false
+	}
+	validIdx := -1
+	for i := 0; i < 2; i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			return nullTypeInfo{}, false
+		}
+		if f.Name == "Valid" && f.Type.Kind() == reflect.Bool {
+			validIdx = i
+		}
+	}
+	if validIdx < 0 {
+		return nullTypeInfo{}, false
+	}
+	valueIdx := 1 - validIdx
+	return nullTypeInfo{valueIndex: valueIdx, validIndex: validIdx, valueType: t.Field(valueIdx).Type}, true
+}
+
+// RegisterNullType registers a custom nullable wrapper for use with WithNullAware.
+// wrapperType is an example value (or pointer) of the wrapper; valueField and validField name the
+// struct fields holding the value and the bool validity flag.
+func (a *Adapter) RegisterNullType(wrapperType any, valueField, validField string) error {
+	t := reflect.TypeOf(wrapperType)
+	if t == nil {
+		return fmt.Errorf("wrapper type must not be nil")
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return fmt.Errorf("wrapper type %s must be a struct", t)
+	}
+	vf, ok := t.FieldByName(valueField)
+	if !ok || len(vf.Index) != 1 || vf.PkgPath != "" {
+		return fmt.Errorf("wrapper type %s has no exported value field %s", t, valueField)
+	}
+	bf, ok := t.FieldByName(validField)
+	if !ok || len(bf.Index) != 1 || bf.PkgPath != "" || bf.Type.Kind() != reflect.Bool {
+		return fmt.Errorf("wrapper type %s has no exported bool field %s", t, validField)
+	}
+	a.nullTypes.Store(t, nullTypeInfo{valueIndex: vf.Index[0], validIndex: bf.Index[0], valueType: vf.Type})
+	return nil
+}
+
+// assignNullAware handles copies where one or both sides are nullable wrappers.
+// It returns false when neither type is a known wrapper or the inner types are incompatible.
+func (a *Adapter) assignNullAware(dstField, srcField reflect.Value) bool {
+	srcInfo, srcIsNull := a.lookupNullType(srcField.Type())
+	dstInfo, dstIsNull := a.lookupNullType(dstField.Type())
+	if !srcIsNull && !dstIsNull {
+		return false
+	}
+	// unwrap source
+	inner := srcField
+	valid := true
+	if srcIsNull {
+		valid = srcField.Field(srcInfo.validIndex).Bool()
+		inner = srcField.Field(srcInfo.valueIndex)
+	}
+	targetType := dstField.Type()
+	if dstIsNull {
+		targetType = dstInfo.valueType
+	}
+	if !inner.Type().AssignableTo(targetType) && !convertibleKind(inner.Type(), targetType) {
+		return false
+	}
+	if !valid {
+		dstField.Set(reflect.Zero(dstField.Type()))
+		return true
+	}
+	if !srcIsNull && a.options.EmptyStringAsNull && inner.Kind() == reflect.String && inner.Len() == 0 {
+		dstField.Set(reflect.Zero(dstField.Type()))
+		return true
+	}
+	if !inner.Type().AssignableTo(targetType) {
+		inner = inner.Convert(targetType)
+	}
+	if dstIsNull {
+		out := reflect.New(dstField.Type()).Elem()
+		out.Field(dstInfo.valueIndex).Set(inner)
+		out.Field(dstInfo.validIndex).SetBool(true)
+		dstField.Set(out)
+		return true
+	}
+	dstField.Set(inner)
+	return true
+}
+
+// nullAwareCompatible is the type-level counterpart of assignNullAware used for static mapping checks.
+func (a *Adapter) nullAwareCompatible(st, dt reflect.Type) bool {
+	srcInfo, srcIsNull := a.lookupNullType(st)
+	dstInfo, dstIsNull := a.lookupNullType(dt)
+	if !srcIsNull && !dstIsNull {
+		return false
+	}
+	if srcIsNull {
+		st = srcInfo.valueType
+	}
+	if dstIsNull {
+		dt = dstInfo.valueType
+	}
+	return st.AssignableTo(dt) || convertibleKind(st, dt)
+}
+
+// convertibleKind reports whether st converts to dt without changing the kind of value: numeric types convert
+// among themselves and any other kind only to the same kind. It rules out conversions reflect allows but that
+// reinterpret the value, such as an integer into a string holding that code point.
+func convertibleKind(st, dt reflect.Type) bool {
+	if !st.ConvertibleTo(dt) {
+		return false
+	}
+	return st.Kind() == dt.Kind() || isNumericKind(st.Kind()) && isNumericKind(dt.Kind())
+}
+
+func isNumericKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package adapters
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"sync"
+)
+
+// Parallel field copies (WithParallelThreshold).
+//
+// Safety: every planned entry that may run in parallel writes one distinct top-level destination field
+// (dstVal.Field(i)), and reflect.Value.Set on distinct fields touches disjoint memory, so workers never write
+// the same bytes. Sources are only read. Plans are only parallel-safe when no entry reaches its destination
+// through an embedded or flattened pointer (fieldByIndexAlloc may allocate a pointer shared by several
+// fields) or through an unexported-field bridge, and field groups are excluded by the same rule. The
+// processed/dstSet bookkeeping is not goroutine-safe, so it is filled in after all workers finish. Converters,
+// validators, FallbackConverter and OnFieldSkipped may run concurrently with each other; they must already be
+// safe for concurrent use, since one Adapter serves concurrent Into calls.
+
+// runsParallel reports whether plan's fields are copied by runFieldsParallel. With a single P there is
+// nothing to gain, so the sequential loop is used.
+func (a *Adapter) runsParallel(plan *buildPlan) bool {
+	n := a.options.ParallelThreshold
+	return n > 0 && len(plan.fields) > n && plan.parallelSafe && runtime.GOMAXPROCS(0) > 1
+}
+
+// parallelSafe reports whether every entry of fields writes a distinct top-level destination field. Coalesced
+// entries are excluded because the source field they consume is only known at run time.
+func parallelSafe(fields []fieldPlan) bool {
+	for i := range fields {
+		if !fields[i]._dstFlat || fields[i].get != nil || fields[i].set != nil || fields[i].alts != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// runFieldsParallel copies plan's fields with up to GOMAXPROCS workers over contiguous chunks. All fields are
+// attempted; the error of the first failing field in plan order is returned, as in a sequential run, but
+// fields after it may already have been written.
+func (a *Adapter) runFieldsParallel(ctx context.Context, dstVal, srcVal reflect.Value, plan *buildPlan, processed, dstSet map[string]bool) error {
+	n := len(plan.fields)
+	workers := runtime.GOMAXPROCS(0)
+	if workers > n {
+		workers = n
+	}
+	done := make([]fieldOutcome, n)
+	errs := make([]error, n)
+	chunk := (n + workers - 1) / workers
+	var wg sync.WaitGroup
+	for lo := 0; lo < n; lo += chunk {
+		hi := lo + chunk
+		if hi > n {
+			hi = n
+		}
+		wg.Add(1)
+		go func(lo, hi int) {
+			defer wg.Done()
+			for i := lo; i < hi; i++ {
+				done[i], errs[i] = a.runField(ctx, &plan.fields[i], dstVal, srcVal)
+			}
+		}(lo, hi)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			return adaptError(plan.fields[i]._dstName, err)
+		}
+		if done[i] != fieldUnreached && processed != nil {
+			fp := &plan.fields[i]
+			processed[fp._srcName] = true
+			dstSet[fp._dstName] = true
+		}
+	}
+	return nil
+}
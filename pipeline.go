@@ -0,0 +1,31 @@
+package adapters
+
+import "fmt"
+
+// RunPipeline adapts src into dst with steps[0], then runs each later step over dst in place
+// (steps[i].Into(dst, dst)), so every stage reads the output of the one before it and can own one concern:
+// one adapter maps the fields, the next normalizes call signs, another validates. No intermediate struct of a
+// different type is needed; the only extra allocation is the snapshot each in-place stage takes of dst (see
+// Into), which also means later stages see every field of dst as its own name match and leave its
+// AdditionalData untouched. For stages that need a distinct intermediate type, chain AdaptTo calls instead.
+// The first failing stage stops the pipeline; its error names the stage index and wraps the stage's error,
+// and dst keeps whatever the stages before it (and the failing stage, up to its failure) wrote.
+func RunPipeline(dst, src interface{}, steps ...*Adapter) error {
+	if len(steps) == 0 {
+		return fmt.Errorf("pipeline has no stages")
+	}
+	for i, a := range steps {
+		if a == nil {
+			return fmt.Errorf("pipeline stage %d: nil adapter", i)
+		}
+	}
+	if err := steps[0].Into(dst, src); err != nil {
+		return fmt.Errorf("pipeline stage 0: %w", err)
+	}
+	for i, a := range steps[1:] {
+		if err := a.Into(dst, dst); err != nil {
+			return fmt.Errorf("pipeline stage %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package adapters
+
+import "reflect"
+
+// isPointerPair reports whether WithAutoPointers copies st into dt: one side is a pointer whose element type
+// copies to or from the other side (*string <-> string), or both are pointers to such types (*int -> *int64).
+// Element types copy when assignable, or convertible with AllowImplicitConvert.
+func (a *Adapter) isPointerPair(st, dt reflect.Type) bool {
+	sp, dp := st.Kind() == reflect.Ptr, dt.Kind() == reflect.Ptr
+	switch {
+	case sp && dp:
+		st, dt = st.Elem(), dt.Elem()
+	case sp:
+		st = st.Elem()
+	case dp:
+		dt = dt.Elem()
+	default:
+		return false
+	}
+	return st.AssignableTo(dt) || a.options.AllowImplicitConvert && st.ConvertibleTo(dt) && st.Kind() != reflect.Ptr
+}
+
+// assignPointer copies srcField into dstField for an isPointerPair pair. A nil source pointer sets the zero
+// value (nil for a pointer destination); otherwise a pointer destination gets a newly allocated value, so
+// source and destination never share memory.
+func assignPointer(dstField, srcField reflect.Value) {
+	if srcField.Kind() == reflect.Ptr {
+		if srcField.IsNil() {
+			dstField.SetZero()
+			return
+		}
+		srcField = srcField.Elem()
+	}
+	target := dstField
+	if dstField.Kind() == reflect.Ptr {
+		target = reflect.New(dstField.Type().Elem()).Elem()
+	}
+	if srcField.Type().AssignableTo(target.Type()) {
+		target.Set(srcField)
+	} else {
+		convertInto(target, srcField)
+	}
+	if dstField.Kind() == reflect.Ptr {
+		dstField.Set(target.Addr())
+	}
+}
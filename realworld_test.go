@@ -4,10 +4,12 @@ import (
 	"github.com/Station-Manager/adapters/converters/common"
 	sqmodels "github.com/Station-Manager/database/sqlite/models"
 	"github.com/Station-Manager/types"
+	"github.com/aarondl/null/v8"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"testing"
+	"time"
 )
 
 type TypeQso struct {
@@ -65,3 +67,99 @@ func (s *TestSuite) TestBasicCopy_ModelToType() {
 	assert.Equal(s.T(), modelQso.ID, typeQso.ID)
 	assert.Equal(s.T(), "14.320", typeQso.Freq)
 }
+
+func (s *TestSuite) TestRST_TypeToModel() {
+	adapter := New()
+	adapter.RegisterConverterFor(sqmodels.Qso{}, "RstSent", common.NormalizeRST)
+	adapter.RegisterConverterFor(sqmodels.Qso{}, "RstRcvd", common.NormalizeRST)
+	adapter.RegisterValidatorFor(sqmodels.Qso{}, "RstSent", common.ValidateRST("CW"))
+	adapter.RegisterValidatorFor(sqmodels.Qso{}, "RstRcvd", common.ValidateRST("CW"))
+
+	typeQso := types.Qso{QsoDetails: types.QsoDetails{Mode: "CW", RstSent: " 5nn", RstRcvd: "579"}}
+	modelQso := sqmodels.Qso{}
+	require.NoError(s.T(), adapter.Into(&modelQso, &typeQso))
+	assert.Equal(s.T(), "599", modelQso.RstSent)
+	assert.Equal(s.T(), "579", modelQso.RstRcvd)
+
+	typeQso.RstRcvd = "59"
+	err := adapter.Into(&sqmodels.Qso{}, &typeQso)
+	require.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), "RST")
+}
+
+type typeLog struct {
+	Logged time.Time
+}
+
+type modelLog struct {
+	Logged null.Time
+}
+
+func (s *TestSuite) TestNullTime_ZeroTypeToModel() {
+	adapter := New()
+	adapter.RegisterConverter("Logged", common.TypeToModelTimeConverter)
+
+	dst := modelLog{Logged: null.TimeFrom(time.Now())}
+	require.NoError(s.T(), adapter.Into(&dst, &typeLog{}))
+	assert.False(s.T(), dst.Logged.Valid)
+
+	when := time.Date(2025, 11, 3, 14, 20, 0, 0, time.UTC)
+	require.NoError(s.T(), adapter.Into(&dst, &typeLog{Logged: when}))
+	assert.True(s.T(), dst.Logged.Valid)
+	assert.True(s.T(), when.Equal(dst.Logged.Time))
+}
+
+type modelPower struct {
+	TxPwr float64
+}
+
+func (s *TestSuite) TestTxPwr_WattsDbmRoundTrip() {
+	toModel := New()
+	toModel.RegisterConverter("TxPwr", common.WattsToDbm)
+	toType := New()
+	toType.RegisterConverter("TxPwr", common.DbmToWatts)
+
+	typeQso := types.Qso{QsoDetails: types.QsoDetails{TxPwr: "100"}}
+	model := modelPower{}
+	require.NoError(s.T(), toModel.Into(&model, &typeQso))
+	assert.Equal(s.T(), 50.0, model.TxPwr)
+
+	back := types.Qso{}
+	require.NoError(s.T(), toType.Into(&back, &model))
+	assert.Equal(s.T(), "100", back.TxPwr)
+}
+
+type typeQsl struct {
+	QslSent  string
+	QslRcvd  string
+	LotwSent string
+}
+
+type modelQsl struct {
+	QslSent  bool
+	QslRcvd  bool
+	LotwSent null.Bool
+}
+
+func (s *TestSuite) TestQsl_ADIFBoolRoundTrip() {
+	toModel := New()
+	toModel.RegisterConverter("QslSent", common.TypeToModelADIFBoolConverter)
+	toModel.RegisterConverter("QslRcvd", common.TypeToModelADIFBoolConverter)
+	toModel.RegisterConverter("LotwSent", common.NewTypeToModelADIFBoolConverter(true))
+	toType := New()
+	for _, f := range []string{"QslSent", "QslRcvd", "LotwSent"} {
+		toType.RegisterConverter(f, common.ModelToTypeADIFBoolConverter)
+	}
+
+	model := modelQsl{}
+	require.NoError(s.T(), toModel.Into(&model, &typeQsl{QslSent: "y", QslRcvd: ""}))
+	assert.Equal(s.T(), modelQsl{QslSent: true}, model)
+
+	back := typeQsl{}
+	require.NoError(s.T(), toType.Into(&back, &model))
+	assert.Equal(s.T(), typeQsl{QslSent: "Y", QslRcvd: "N"}, back)
+
+	err := toModel.Into(&modelQsl{}, &typeQsl{QslSent: "X"})
+	require.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), "QslSent")
+}
@@ -0,0 +1,198 @@
+package adapters
+
+import (
+	"context"
+	"reflect"
+	"strings"
+)
+
+// Disposition is what happened to a source field during IntoReport.
+type Disposition int
+
+const (
+	// Dropped: the value reached no destination field and no AdditionalData.
+	Dropped Disposition = iota
+	// Copied: the value was assigned to a destination field directly or by an implicit rule
+	// (convertible, null-aware, nested or JSON text fields).
+	Copied
+	// Converted: the value was assigned to a destination field through a converter.
+	Converted
+	// MarshaledToAD: the value was marshaled into destination AdditionalData.
+	MarshaledToAD
+	// Ignored: the field does not take part in this direction.
+	Ignored
+)
+
+func (d Disposition) String() string {
+	switch d {
+	case Dropped:
+		return "dropped"
+	case Copied:
+		return "copied"
+	case Converted:
+		return "converted"
+	case MarshaledToAD:
+		return "additional"
+	case Ignored:
+		return "ignored"
+	}
+	return "unknown"
+}
+
+// FieldDisposition is the fate of one source field in a Report.
+type FieldDisposition struct {
+	Field       string // source field Go name
+	Disposition Disposition
+	Dst         string // destination field written ("Group.Member" for field groups); set for Copied, Converted and incompatible drops
+	// Detail qualifies the disposition. Converted: the converter's scope ("pair", "dst", "context", "value",
+	// "global", "json", "enum", "time" or "fallback"). Dropped: "incompatible", "unmatched", "zero" (an
+	// unmatched zero value left out of AdditionalData) or "unreachable" (behind a nil embedded pointer).
+	// Ignored: "ignore", "writeonly" or "readonly" (matched a readonly destination field).
+	Detail string
+}
+
+// Report lists what IntoReport did with each source field, in source declaration order.
+// The source AdditionalData field itself is not listed.
+type Report struct {
+	Fields []FieldDisposition
+}
+
+// Field returns the disposition of the named source field.
+func (r Report) Field(name string) (FieldDisposition, bool) {
+	for _, f := range r.Fields {
+		if f.Field == name {
+			return f, true
+		}
+	}
+	return FieldDisposition{}, false
+}
+
+// String renders the report on one line for debug logs, e.g. "Call=copied(Call) Freq=converted(Freq,pair) Rig=additional".
+func (r Report) String() string {
+	var b strings.Builder
+	for i, f := range r.Fields {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(f.Field)
+		b.WriteByte('=')
+		b.WriteString(f.Disposition.String())
+		var args []string
+		if f.Dst != "" {
+			args = append(args, f.Dst)
+		}
+		if f.Detail != "" {
+			args = append(args, f.Detail)
+		}
+		if len(args) > 0 {
+			b.WriteString("(" + strings.Join(args, ",") + ")")
+		}
+	}
+	return b.String()
+}
+
+// IntoReport performs Into and reports, per source field, whether it was copied, converted, marshaled into
+// destination AdditionalData, ignored or dropped. The report is recorded during the adaptation itself, so it
+// reflects the actual values (a zero value that Into leaves out of AdditionalData is reported as dropped),
+// unlike the static CheckMapping. Only the top-level struct is reported; fields of nested structs are not.
+// A source field written to several destinations (fanout) reports the first one. The adaptation runs
+// sequentially even with WithParallelThreshold. On error the report is empty.
+func (a *Adapter) IntoReport(dst, src interface{}) (Report, error) {
+	rec := &reportRecorder{byName: make(map[string]FieldDisposition)}
+	if err := a.IntoCtx(context.WithValue(context.Background(), reportKey{}, rec), dst, src); err != nil {
+		return Report{}, err
+	}
+	return Report{Fields: rec.fields}, nil
+}
+
+type reportKey struct{}
+
+// reportRecorder collects dispositions for the first runPlan call of an IntoReport; nested adaptations
+// find it already claimed.
+type reportRecorder struct {
+	claimed bool
+	byName  map[string]FieldDisposition
+	fields  []FieldDisposition
+}
+
+// claimReport returns the recorder carried by ctx, unless there is none or an outer runPlan already claimed it.
+func claimReport(ctx context.Context) *reportRecorder {
+	rec, _ := ctx.Value(reportKey{}).(*reportRecorder)
+	if rec == nil || rec.claimed {
+		return nil
+	}
+	rec.claimed = true
+	return rec
+}
+
+// field records what runField did with fp; an earlier write of the same source field takes precedence
+// over a later one, except over an incompatible drop.
+func (r *reportRecorder) field(fp *fieldPlan, outcome fieldOutcome) {
+	if prev, ok := r.byName[fp._srcName]; ok && prev.Disposition != Dropped {
+		return
+	}
+	d := FieldDisposition{Field: fp._srcName, Dst: fp._dstName}
+	switch outcome {
+	case fieldUnreached:
+		return
+	case fieldCopied:
+		d.Disposition = Copied
+	case fieldConverted:
+		d.Disposition, d.Detail = Converted, fp.convScope
+	case fieldFallback:
+		d.Disposition, d.Detail = Converted, "fallback"
+	case fieldSkipped:
+		d.Disposition, d.Detail = Dropped, "incompatible"
+	}
+	r.byName[fp._srcName] = d
+}
+
+// finishReport classifies the source fields no plan entry recorded and lays out the report in source order.
+// It runs after AdditionalData was marshaled.
+func (a *Adapter) finishReport(rec *reportRecorder, plan *buildPlan, srcVal reflect.Value, srcMeta *structMetadata) {
+	toAD := plan.dstHasAD && !a.options.DisableMarshalAdditionalData
+	readonly := make(map[string]bool, len(plan.readonlySrc))
+	for _, name := range plan.readonlySrc {
+		readonly[name] = true
+	}
+	rec.fields = make([]FieldDisposition, 0, len(srcMeta.fields))
+	for i := range srcMeta.fields {
+		sf := &srcMeta.fields[i]
+		if sf.isAdditionalData {
+			continue
+		}
+		d, ok := rec.byName[sf.name]
+		if !ok {
+			d = FieldDisposition{Field: sf.name}
+			switch {
+			case sf.ignore:
+				d.Disposition, d.Detail = Ignored, "ignore"
+			case sf.writeonly:
+				d.Disposition, d.Detail = Ignored, "writeonly"
+			case readonly[sf.name]:
+				d.Disposition, d.Detail = Ignored, "readonly"
+			default:
+				d.Detail = a.unrecordedDetail(sf, srcVal, toAD)
+				if d.Detail == "" {
+					d.Disposition = MarshaledToAD
+				}
+			}
+		}
+		rec.fields = append(rec.fields, d)
+	}
+}
+
+// unrecordedDetail returns why an unmatched source field was dropped, or "" when it went to AdditionalData.
+func (a *Adapter) unrecordedDetail(sf *fieldInfo, srcVal reflect.Value, toAD bool) string {
+	v, reachable := a.safeFieldByIndex(srcVal, sf.index)
+	switch {
+	case !reachable:
+		return "unreachable"
+	case toAD && !a.hiddenFromAD(sf) && v.CanInterface() && a.droppedAsZero(sf, v):
+		return "zero"
+	}
+	if _, ok := a.adCandidate(sf, srcVal); toAD && ok {
+		return ""
+	}
+	return "unmatched"
+}
@@ -0,0 +1,72 @@
+package adapters
+
+import (
+	"reflect"
+
+	"github.com/goccy/go-json"
+)
+
+// maxPooledEntries bounds the maps kept in scratch; Go maps never shrink, so one huge struct or
+// AdditionalData payload would otherwise pin its memory in the pool.
+const maxPooledEntries = 128
+
+// scratch holds the per-call temporaries of runPlan. It is taken from Adapter.scratchPool when a plan that
+// touches AdditionalData starts and returned when that call ends. Lifetime rules:
+//   - nothing in it may be referenced after the runPlan call that took it returns (nested adaptations take
+//     their own scratch);
+//   - maps are cleared when the scratch is returned, so the pool never keeps caller data reachable;
+//   - values decoded from adIn and values stored in adOut are not pooled: decoded values are assigned to
+//     destination fields and adOut is marshaled to fresh bytes before the call ends.
+type scratch struct {
+	processed map[string]bool            // source fields consumed by the plan
+	dstSet    map[string]bool            // destination fields written by the plan
+	adIn      map[string]json.RawMessage // decoded source AdditionalData
+	adOut     map[string]interface{}     // source fields bound for destination AdditionalData
+}
+
+func (a *Adapter) getScratch() *scratch { return a.scratchPool.Get().(*scratch) }
+
+// putScratch clears sc, so pooled scratch never keeps caller data reachable, and returns it to the pool.
+func (a *Adapter) putScratch(sc *scratch) {
+	if len(sc.processed) > maxPooledEntries || len(sc.dstSet) > maxPooledEntries {
+		sc.processed, sc.dstSet = make(map[string]bool), make(map[string]bool)
+	}
+	if len(sc.adIn) > maxPooledEntries {
+		sc.adIn = nil
+	}
+	if len(sc.adOut) > maxPooledEntries {
+		sc.adOut = nil
+	}
+	clear(sc.processed)
+	clear(sc.dstSet)
+	clear(sc.adIn)
+	clear(sc.adOut)
+	a.scratchPool.Put(sc)
+}
+
+func newScratch() interface{} {
+	return &scratch{processed: make(map[string]bool), dstSet: make(map[string]bool)}
+}
+
+// convertInto assigns src to dst for convertible types. Numeric, bool and string kinds are set directly,
+// which avoids the allocation reflect.Value.Convert makes for its result; other kinds use Convert.
+func convertInto(dst, src reflect.Value) {
+	switch {
+	case isSignedKind(src.Kind()) && isSignedKind(dst.Kind()):
+		dst.SetInt(src.Int())
+	case isUnsignedKind(src.Kind()) && isUnsignedKind(dst.Kind()):
+		dst.SetUint(src.Uint())
+	case isFloatKind(src.Kind()) && isFloatKind(dst.Kind()):
+		dst.SetFloat(src.Float())
+	case src.Kind() == reflect.Bool && dst.Kind() == reflect.Bool:
+		dst.SetBool(src.Bool())
+	case src.Kind() == reflect.String && dst.Kind() == reflect.String:
+		dst.SetString(src.String())
+	default:
+		dst.Set(src.Convert(dst.Type()))
+	}
+}
+
+func isSignedKind(k reflect.Kind) bool   { return k >= reflect.Int && k <= reflect.Int64 }
+func isUnsignedKind(k reflect.Kind) bool { return k >= reflect.Uint && k <= reflect.Uintptr }
+func isFloatKind(k reflect.Kind) bool    { return k == reflect.Float32 || k == reflect.Float64 }
@@ -0,0 +1,201 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// AppendAdapted adapts a single source struct into a new element and appends it to the slice pointed to by dstSlicePtr.
+// dstSlicePtr must be a pointer to a slice of structs (or of pointers to structs); src must be a pointer to a struct.
+// Plans are cached per element type, so repeated calls in a loop only pay the reflection cost once.
+func (a *Adapter) AppendAdapted(dstSlicePtr interface{}, src interface{}) error {
+	if dstSlicePtr == nil || src == nil {
+		return fmt.Errorf("src and dst must not be nil")
+	}
+	sliceVal, elemType, elemIsPtr, err := sliceTarget(dstSlicePtr)
+	if err != nil {
+		return err
+	}
+	srcVal := reflect.ValueOf(src)
+	if srcVal.Kind() != reflect.Ptr || srcVal.IsNil() {
+		return fmt.Errorf("src must be a non-nil pointer")
+	}
+	srcVal = srcVal.Elem()
+	if srcVal.Kind() != reflect.Struct {
+		return fmt.Errorf("src must point to a struct")
+	}
+	elem := reflect.New(elemType)
+	if err := a.adaptStruct(elem.Elem(), srcVal); err != nil {
+		return err
+	}
+	if elemIsPtr {
+		sliceVal.Set(reflect.Append(sliceVal, elem))
+	} else {
+		sliceVal.Set(reflect.Append(sliceVal, elem.Elem()))
+	}
+	return nil
+}
+
+// AdaptAll adapts a heterogeneous batch: for each item (a struct or pointer to a struct) newDst returns a fresh
+// pointer to the destination struct for that item, which is adapted into and collected in order. This suits
+// pipelines where the destination type depends on the source type. On the first failure, including newDst
+// returning something other than a non-nil struct pointer, AdaptAll returns the results adapted so far and an
+// error carrying the item index.
+func (a *Adapter) AdaptAll(items []interface{}, newDst func(src interface{}) interface{}) ([]interface{}, error) {
+	if newDst == nil {
+		return nil, fmt.Errorf("newDst must not be nil")
+	}
+	out := make([]interface{}, 0, len(items))
+	for i, item := range items {
+		dst := newDst(item)
+		dv := reflect.ValueOf(dst)
+		if dv.Kind() != reflect.Ptr || dv.IsNil() || dv.Elem().Kind() != reflect.Struct {
+			return out, fmt.Errorf("adapting item %d (%T): newDst returned %T, want a non-nil pointer to a struct", i, item, dst)
+		}
+		if err := a.IntoValue(dv, reflect.ValueOf(item)); err != nil {
+			return out, fmt.Errorf("adapting item %d (%T): %w", i, item, err)
+		}
+		out = append(out, dst)
+	}
+	return out, nil
+}
+
+// sliceTarget validates a pointer-to-slice destination and returns the settable slice value,
+// the underlying struct element type and whether elements are pointers to that struct.
+func sliceTarget(dstSlicePtr interface{}) (reflect.Value, reflect.Type, bool, error) {
+	pv := reflect.ValueOf(dstSlicePtr)
+	if pv.Kind() != reflect.Ptr || pv.IsNil() || pv.Elem().Kind() != reflect.Slice {
+		return reflect.Value{}, nil, false, fmt.Errorf("dst must be a pointer to a slice of structs")
+	}
+	sliceVal := pv.Elem()
+	et := sliceVal.Type().Elem()
+	isPtr := false
+	if et.Kind() == reflect.Ptr {
+		isPtr = true
+		et = et.Elem()
+	}
+	if et.Kind() != reflect.Struct {
+		return reflect.Value{}, nil, false, fmt.Errorf("dst must be a pointer to a slice of structs")
+	}
+	return sliceVal, et, isPtr, nil
+}
+
+// AdaptSliceCtx adapts every element of src (a slice, or pointer to a slice, of structs or struct pointers) into
+// a new slice stored in *dstSlicePtr, replacing its previous contents. ctx is checked before each element; on
+// cancellation or an element error the destination holds the elements adapted so far, or is left untouched when
+// WithRollbackOnCancel is enabled. Nil source pointers produce nil (or zero) destination elements.
+func (a *Adapter) AdaptSliceCtx(ctx context.Context, dstSlicePtr interface{}, src interface{}) error {
+	if dstSlicePtr == nil || src == nil {
+		return fmt.Errorf("src and dst must not be nil")
+	}
+	sliceVal, elemType, elemIsPtr, err := sliceTarget(dstSlicePtr)
+	if err != nil {
+		return err
+	}
+	srcVal := reflect.ValueOf(src)
+	if srcVal.Kind() == reflect.Ptr {
+		srcVal = srcVal.Elem()
+	}
+	if srcVal.Kind() != reflect.Slice && srcVal.Kind() != reflect.Array {
+		return fmt.Errorf("src must be a slice of structs")
+	}
+	n := srcVal.Len()
+	out := reflect.MakeSlice(sliceVal.Type(), 0, n)
+	for i := 0; i < n; i++ {
+		if err := ctx.Err(); err != nil {
+			a.commitPartial(sliceVal, out)
+			return err
+		}
+		elem, err := a.adaptElement(srcVal.Index(i), elemType, elemIsPtr)
+		if err != nil {
+			a.commitPartial(sliceVal, out)
+			return fmt.Errorf("adapting element %d: %w", i, err)
+		}
+		out = reflect.Append(out, elem)
+	}
+	sliceVal.Set(out)
+	return nil
+}
+
+// AdaptMapCtx adapts every value of src (a map, or pointer to a map, with struct or struct pointer values) into
+// a new map stored in *dstMapPtr, keeping keys. Key types must be assignable. Cancellation and error handling
+// follow AdaptSliceCtx; since map iteration order is random, a partial result holds an arbitrary subset.
+func (a *Adapter) AdaptMapCtx(ctx context.Context, dstMapPtr interface{}, src interface{}) error {
+	if dstMapPtr == nil || src == nil {
+		return fmt.Errorf("src and dst must not be nil")
+	}
+	pv := reflect.ValueOf(dstMapPtr)
+	if pv.Kind() != reflect.Ptr || pv.IsNil() || pv.Elem().Kind() != reflect.Map {
+		return fmt.Errorf("dst must be a pointer to a map of structs")
+	}
+	mapVal := pv.Elem()
+	elemType := mapVal.Type().Elem()
+	elemIsPtr := elemType.Kind() == reflect.Ptr
+	if elemIsPtr {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		return fmt.Errorf("dst must be a pointer to a map of structs")
+	}
+	srcVal := reflect.ValueOf(src)
+	if srcVal.Kind() == reflect.Ptr {
+		srcVal = srcVal.Elem()
+	}
+	if srcVal.Kind() != reflect.Map {
+		return fmt.Errorf("src must be a map of structs")
+	}
+	if !srcVal.Type().Key().AssignableTo(mapVal.Type().Key()) {
+		return fmt.Errorf("map key type %s is not assignable to %s", srcVal.Type().Key(), mapVal.Type().Key())
+	}
+	out := reflect.MakeMapWithSize(mapVal.Type(), srcVal.Len())
+	iter := srcVal.MapRange()
+	for iter.Next() {
+		if err := ctx.Err(); err != nil {
+			a.commitPartial(mapVal, out)
+			return err
+		}
+		elem, err := a.adaptElement(iter.Value(), elemType, elemIsPtr)
+		if err != nil {
+			a.commitPartial(mapVal, out)
+			return fmt.Errorf("adapting key %v: %w", iter.Key(), err)
+		}
+		out.SetMapIndex(iter.Key(), elem)
+	}
+	mapVal.Set(out)
+	return nil
+}
+
+// commitPartial stores a partially built collection unless RollbackOnCancel is set.
+func (a *Adapter) commitPartial(dst, partial reflect.Value) {
+	if !a.options.RollbackOnCancel {
+		dst.Set(partial)
+	}
+}
+
+// adaptElement adapts a single struct (or struct pointer) source element into a new destination element.
+func (a *Adapter) adaptElement(src reflect.Value, elemType reflect.Type, elemIsPtr bool) (reflect.Value, error) {
+	if src.Kind() == reflect.Interface {
+		src = src.Elem()
+	}
+	if src.Kind() == reflect.Ptr {
+		if src.IsNil() {
+			if elemIsPtr {
+				return reflect.Zero(reflect.PointerTo(elemType)), nil
+			}
+			return reflect.Zero(elemType), nil
+		}
+		src = src.Elem()
+	}
+	if src.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("source element must be a struct, got %s", src.Kind())
+	}
+	elem := reflect.New(elemType)
+	if err := a.adaptStruct(elem.Elem(), src); err != nil {
+		return reflect.Value{}, err
+	}
+	if elemIsPtr {
+		return elem, nil
+	}
+	return elem.Elem(), nil
+}
@@ -0,0 +1,55 @@
+package adapters
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/aarondl/null/v8"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	nullTimeType = reflect.TypeOf(null.Time{})
+)
+
+// timeLayoutConverter returns the WithTimeLayout converter producing values of dt from values of st, or nil
+// when the pair is not a string kind and time.Time or null.Time. An empty string adapts to the zero time (an
+// invalid null.Time) and a zero time or invalid null.Time to "".
+func (a *Adapter) timeLayoutConverter(st, dt reflect.Type) ConverterFunc {
+	parse, format := a.options.TimeParseLayout, a.options.TimeFormatLayout
+	switch {
+	case st.Kind() == reflect.String && (dt == timeType || dt == nullTimeType) && parse != "":
+		return func(src interface{}) (interface{}, error) {
+			s := reflect.ValueOf(src).String()
+			if s == "" {
+				return nil, nil
+			}
+			t, err := time.Parse(parse, s)
+			if err != nil {
+				return nil, err
+			}
+			if dt == nullTimeType {
+				return null.TimeFrom(t), nil
+			}
+			return t, nil
+		}
+	case (st == timeType || st == nullTimeType) && dt.Kind() == reflect.String && format != "":
+		return func(src interface{}) (interface{}, error) {
+			var t time.Time
+			switch v := src.(type) {
+			case time.Time:
+				t = v
+			case null.Time:
+				if !v.Valid {
+					return nil, nil
+				}
+				t = v.Time
+			}
+			if t.IsZero() {
+				return nil, nil
+			}
+			return reflect.ValueOf(t.Format(format)).Convert(dt).Interface(), nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package adapters
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ToMap returns a flat map view of src (a struct or pointer to struct) keyed by Go field name, intended
+// for structured logging. Fields promoted from embedded structs appear at the top level and nil embedded
+// pointers contribute nothing. Ignored, writeonly and AdditionalData fields are left out, and zero values
+// are skipped unless IncludeZeroValues or an adapter:"includezero" tag keeps them (adapter:"omitzero" skips
+// them regardless), matching how fields are marshaled into AdditionalData.
+// Global converters (or json-name converters) registered for a field are applied to its value, so e.g.
+// stored codes can be rendered as their human-readable form.
+func (a *Adapter) ToMap(src interface{}) (map[string]interface{}, error) {
+	if src == nil {
+		return nil, fmt.Errorf("src must not be nil")
+	}
+	srcVal := reflect.ValueOf(src)
+	if srcVal.Kind() == reflect.Ptr {
+		if srcVal.IsNil() {
+			return nil, fmt.Errorf("src must not be nil")
+		}
+		srcVal = srcVal.Elem()
+	}
+	if srcVal.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("src must be a struct or pointer to struct")
+	}
+	srcMeta := a.getOrBuildMetadata(srcVal.Type())
+	if err := metadataErr(srcMeta); err != nil {
+		return nil, err
+	}
+	reg := a.converters.Load().(*converterRegistry)
+	out := make(map[string]interface{}, len(srcMeta.fields))
+	for i := range srcMeta.fields {
+		sf := &srcMeta.fields[i]
+		if sf.isAdditionalData || sf.ignore || sf.writeonly {
+			continue
+		}
+		srcField, ok := a.safeFieldByIndex(srcVal, sf.index)
+		if !ok || !srcField.CanInterface() {
+			continue
+		}
+		if !a.keepZero(sf) && srcField.IsZero() {
+			continue
+		}
+		fn := reg.global[sf.name]
+		if fn == nil && sf.jsonName != "" {
+			fn = reg.byJSON[sf.jsonName]
+		}
+		if fn == nil {
+			out[sf.name] = srcField.Interface()
+			continue
+		}
+		v, err := fn(srcField.Interface())
+		if err != nil {
+			return nil, fmt.Errorf("converting field %s: %w", sf.name, err)
+		}
+		out[sf.name] = v
+	}
+	return out, nil
+}
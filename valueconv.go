@@ -0,0 +1,41 @@
+package adapters
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ValueConverterFunc is a converter working on reflect.Values, for hot converters that already operate on
+// reflection: the source field is passed without boxing it into an interface{}, and the result is assigned
+// without a reflect.ValueOf. An invalid (zero) reflect.Value resets the destination to its zero value.
+// ConverterFunc remains the primary API; use this only where profiling shows the boxing matters.
+type ValueConverterFunc func(src reflect.Value) (reflect.Value, error)
+
+// RegisterConverterValue adds a global reflect.Value converter for fieldName. It sits between context-aware
+// and plain global converters: it wins over RegisterConverter for the same field, while destination- and
+// pair-scoped converters and RegisterContextConverter win over it. The returned value must be assignable to
+// the destination field. The src Value must not be retained or modified.
+func (a *Adapter) RegisterConverterValue(fieldName string, fn ValueConverterFunc) {
+	newReg := a.converters.Load().(*converterRegistry).clone()
+	newReg.value[fieldName] = fn
+	a.converters.Store(newReg)
+	a.gen.Add(1)
+}
+
+// applyValueConverter runs fn on srcField and assigns the result to dstField. Only the error path boxes the
+// source value, to render it in the message.
+func applyValueConverter(dstField reflect.Value, fn ValueConverterFunc, srcField reflect.Value, fieldName string) error {
+	out, err := fn(srcField)
+	if err != nil {
+		return converterError(fieldName, srcField.Interface(), err)
+	}
+	if !out.IsValid() {
+		dstField.SetZero()
+		return nil
+	}
+	if !out.Type().AssignableTo(dstField.Type()) {
+		return fatal(fmt.Errorf("converter returned type %s, expected %s", out.Type(), dstField.Type()))
+	}
+	dstField.Set(out)
+	return nil
+}